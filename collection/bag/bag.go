@@ -1,3 +1,79 @@
 package bag
 
-// TODO: IMPLEMENT ME
+import (
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/set"
+)
+
+// Entry a Bag item along with its number of occurrences.
+//
+// T: Value's type.
+type Entry[T comparable] struct {
+	Value T
+	Count int
+}
+
+// Bag a collection.ComparableCollection that counts the number of times an element appears in it
+// (also known as a multiset).
+//
+// Unlike sets, bags allow duplicate elements; Len returns the total number of occurrences while UniqueSet exposes
+// distinct elements only.
+type Bag[T comparable] interface {
+	collection.ComparableCollection[T]
+	// Count returns the number of occurrences of the specified element in this bag.
+	Count(v T) int
+	// AddN adds n occurrences of the specified element into this bag.
+	AddN(v T, n int) bool
+	// Remove removes a single occurrence of the specified element from this bag if it is present.
+	Remove(v T) bool
+	// RemoveN removes up to n occurrences of the specified element from this bag, returning the number of
+	// occurrences actually removed.
+	RemoveN(v T, n int) int
+	// UniqueSet returns a set.Set holding the distinct elements of this bag.
+	UniqueSet() set.Set[T]
+	// EntrySet returns a set.Set holding the distinct elements of this bag along with their number of occurrences.
+	EntrySet() set.Set[Entry[T]]
+}
+
+// Union returns a new Bag where every element occurs the maximum number of times it occurs in either bag.
+func Union[T comparable](a, b Bag[T]) Bag[T] {
+	dst := NewHashBagFromBag(a)
+	b.EntrySet().ForEach(func(entry Entry[T]) bool {
+		if diff := entry.Count - dst.Count(entry.Value); diff > 0 {
+			dst.AddN(entry.Value, diff)
+		}
+		return false
+	})
+	return dst
+}
+
+// Intersection returns a new Bag where every element occurs the minimum number of times it occurs in both bags.
+func Intersection[T comparable](a, b Bag[T]) Bag[T] {
+	dst := NewHashBag[T]()
+	a.EntrySet().ForEach(func(entry Entry[T]) bool {
+		dst.AddN(entry.Value, min(entry.Count, b.Count(entry.Value)))
+		return false
+	})
+	return dst
+}
+
+// Sum returns a new Bag where every element occurs the sum of the number of times it occurs in both bags.
+func Sum[T comparable](a, b Bag[T]) Bag[T] {
+	dst := NewHashBagFromBag(a)
+	b.EntrySet().ForEach(func(entry Entry[T]) bool {
+		dst.AddN(entry.Value, entry.Count)
+		return false
+	})
+	return dst
+}
+
+// Difference returns a new Bag where every element occurs the number of times it occurs in 'a' minus the number of
+// times it occurs in 'b'. Elements whose result is zero or negative are not present.
+func Difference[T comparable](a, b Bag[T]) Bag[T] {
+	dst := NewHashBagFromBag(a)
+	b.EntrySet().ForEach(func(entry Entry[T]) bool {
+		dst.RemoveN(entry.Value, entry.Count)
+		return false
+	})
+	return dst
+}
@@ -1,3 +1,195 @@
 package bag
 
-// TODO: IMPLEMENT ME
+import (
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/collection/set"
+)
+
+// HashBag is the Go's map implementation of Bag. Each distinct element is mapped to its number of occurrences,
+// thus Count, AddN and RemoveN take O(1) time.
+type HashBag[T comparable] struct {
+	counts map[T]int
+	len    int
+}
+
+var _ Bag[string] = &HashBag[string]{}
+
+// NewHashBag allocates a new HashBag instance.
+func NewHashBag[T comparable]() *HashBag[T] {
+	return &HashBag[T]{
+		counts: make(map[T]int),
+	}
+}
+
+// NewHashBagFromSlice allocates a new HashBag instance copying 'src' items into it.
+func NewHashBagFromSlice[T comparable](src []T) *HashBag[T] {
+	b := NewHashBag[T]()
+	b.AddSlice(src...)
+	return b
+}
+
+// NewHashBagFromBag allocates a new HashBag instance copying 'src' entries into it.
+func NewHashBagFromBag[T comparable](src Bag[T]) *HashBag[T] {
+	b := NewHashBag[T]()
+	src.EntrySet().ForEach(func(entry Entry[T]) bool {
+		b.AddN(entry.Value, entry.Count)
+		return false
+	})
+	return b
+}
+
+// NewIterator returns an iterator over elements of type T. Elements occurring several times are yielded once per
+// occurrence, contiguously.
+func (h *HashBag[T]) NewIterator() collection.Iterator[T] {
+	return list.NewIterator[T](list.NewSliceList(h.ToSlice()))
+}
+
+// Add adds an element into this collection.
+func (h *HashBag[T]) Add(v T) bool {
+	return h.AddN(v, 1)
+}
+
+// AddAll adds all the elements into this collection.
+func (h *HashBag[T]) AddAll(src collection.Collection[T]) bool {
+	wasMod := false
+	src.ForEach(func(a T) bool {
+		wasMod = h.AddN(a, 1) || wasMod
+		return false
+	})
+	return wasMod
+}
+
+// AddSlice adds all the elements in the specified slice (variadic) to this collection.
+func (h *HashBag[T]) AddSlice(items ...T) bool {
+	for _, item := range items {
+		h.AddN(item, 1)
+	}
+	return len(items) > 0
+}
+
+// AddN adds n occurrences of the specified element into this bag.
+func (h *HashBag[T]) AddN(v T, n int) bool {
+	if n <= 0 {
+		return false
+	}
+	if h.counts == nil {
+		h.counts = make(map[T]int)
+	}
+	h.counts[v] += n
+	h.len += n
+	return true
+}
+
+// Remove removes a single occurrence of the specified element from this bag if it is present.
+func (h *HashBag[T]) Remove(v T) bool {
+	return h.RemoveN(v, 1) == 1
+}
+
+// RemoveN removes up to n occurrences of the specified element from this bag, returning the number of
+// occurrences actually removed.
+func (h *HashBag[T]) RemoveN(v T, n int) int {
+	count, ok := h.counts[v]
+	if !ok || n <= 0 {
+		return 0
+	}
+	if n >= count {
+		delete(h.counts, v)
+		h.len -= count
+		return count
+	}
+	h.counts[v] = count - n
+	h.len -= n
+	return n
+}
+
+// Count returns the number of occurrences of the specified element in this bag.
+func (h *HashBag[T]) Count(v T) int {
+	return h.counts[v]
+}
+
+// UniqueSet returns a set.Set holding the distinct elements of this bag.
+func (h *HashBag[T]) UniqueSet() set.Set[T] {
+	st := make(set.HashSet[T], len(h.counts))
+	for v := range h.counts {
+		st.Add(v)
+	}
+	return st
+}
+
+// EntrySet returns a set.Set holding the distinct elements of this bag along with their number of occurrences.
+func (h *HashBag[T]) EntrySet() set.Set[Entry[T]] {
+	st := make(set.HashSet[Entry[T]], len(h.counts))
+	for v, count := range h.counts {
+		st.Add(Entry[T]{Value: v, Count: count})
+	}
+	return st
+}
+
+// Clear removes all the elements from this collection.
+func (h *HashBag[T]) Clear() {
+	clear(h.counts)
+	h.len = 0
+}
+
+// Len returns the total number of occurrences in this collection.
+func (h *HashBag[T]) Len() int {
+	return h.len
+}
+
+// IsEmpty returns true if this collection contains no elements.
+func (h *HashBag[T]) IsEmpty() bool {
+	return h.len == 0
+}
+
+// ToSlice returns all the elements from this collection as a slice of T, one item per occurrence.
+func (h *HashBag[T]) ToSlice() []T {
+	buf := make([]T, 0, h.len)
+	for v, count := range h.counts {
+		for i := 0; i < count; i++ {
+			buf = append(buf, v)
+		}
+	}
+	return buf
+}
+
+// Contains returns true if this collection contains the specified element.
+func (h *HashBag[T]) Contains(v T) bool {
+	_, ok := h.counts[v]
+	return ok
+}
+
+// ContainsAll returns true if this collection contains all the elements in the specified collection.
+// Number of occurrences are not taken into account.
+func (h *HashBag[T]) ContainsAll(src collection.Collection[T]) bool {
+	iter := src.NewIterator()
+	for iter.HasNext() {
+		if _, ok := h.counts[iter.Next()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsSlice returns true if this collection contains all the elements in the specified slice.
+// Number of occurrences are not taken into account.
+func (h *HashBag[T]) ContainsSlice(src ...T) bool {
+	for _, item := range src {
+		if _, ok := h.counts[item]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ForEach traverses through all the elements from this collection, once per occurrence.
+// Use predicate's return value to indicate a break of the iteration, TRUE meaning a break.
+func (h *HashBag[T]) ForEach(predicateFunc collection.IterablePredicateFunc[T]) {
+	for v, count := range h.counts {
+		for i := 0; i < count; i++ {
+			if willBreak := predicateFunc(v); willBreak {
+				return
+			}
+		}
+	}
+}
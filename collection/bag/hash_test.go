@@ -0,0 +1,75 @@
+package bag_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/bag"
+)
+
+func TestHashBag(t *testing.T) {
+	b := bag.NewHashBagFromSlice([]string{"err_timeout", "err_auth", "err_timeout"})
+	assert.Equal(t, 3, b.Len())
+	assert.Equal(t, 2, b.Count("err_timeout"))
+	assert.True(t, b.AddN("err_auth", 3))
+	assert.False(t, b.AddN("err_auth", 0))
+	assert.Equal(t, 4, b.Count("err_auth"))
+	assert.Equal(t, 2, b.RemoveN("err_timeout", 5))
+	assert.False(t, b.Contains("err_timeout"))
+	assert.True(t, b.Remove("err_auth"))
+	assert.False(t, b.Remove("err_timeout"))
+	assert.Equal(t, 3, b.Len())
+	assert.Equal(t, []string{"err_auth", "err_auth", "err_auth"}, b.ToSlice())
+	assert.True(t, b.UniqueSet().Contains("err_auth"))
+	assert.Equal(t, 1, b.UniqueSet().Len())
+	assert.True(t, b.EntrySet().Contains(bag.Entry[string]{Value: "err_auth", Count: 3}))
+}
+
+func TestBag_Algebra(t *testing.T) {
+	a := bag.NewHashBagFromSlice([]string{"x", "x", "x", "y", "z"})
+	b := bag.NewHashBagFromSlice([]string{"x", "y", "y", "w"})
+
+	tests := []struct {
+		name string
+		out  bag.Bag[string]
+		exp  map[string]int
+	}{
+		{
+			name: "union",
+			out:  bag.Union[string](a, b),
+			exp:  map[string]int{"x": 3, "y": 2, "z": 1, "w": 1},
+		},
+		{
+			name: "intersection",
+			out:  bag.Intersection[string](a, b),
+			exp:  map[string]int{"x": 1, "y": 1},
+		},
+		{
+			name: "sum",
+			out:  bag.Sum[string](a, b),
+			exp:  map[string]int{"x": 4, "y": 3, "z": 1, "w": 1},
+		},
+		{
+			name: "difference",
+			out:  bag.Difference[string](a, b),
+			exp:  map[string]int{"x": 2, "z": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total := 0
+			for v, count := range tt.exp {
+				assert.Equal(t, count, tt.out.Count(v), v)
+				total += count
+			}
+			assert.Equal(t, total, tt.out.Len())
+			assert.Equal(t, len(tt.exp), tt.out.UniqueSet().Len())
+		})
+	}
+
+	// operands are left untouched
+	assert.Equal(t, 5, a.Len())
+	assert.Equal(t, 4, b.Len())
+}
@@ -0,0 +1,218 @@
+package graph
+
+import (
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/collection/set"
+)
+
+// adjacency is a structure used to hold the neighbors of a vertex, in insertion order, along with the weights of the
+// edges connecting them.
+type adjacency[V comparable, W any] struct {
+	neighbors *set.LinkedHashSet[V]
+	weights   map[V]W
+}
+
+func newAdjacency[V comparable, W any]() *adjacency[V, W] {
+	return &adjacency[V, W]{
+		neighbors: set.NewLinkedHashSet[V](),
+		weights:   make(map[V]W),
+	}
+}
+
+func (a *adjacency[V, W]) put(v V, weight W) bool {
+	a.weights[v] = weight
+	return a.neighbors.Add(v)
+}
+
+func (a *adjacency[V, W]) remove(v V) bool {
+	delete(a.weights, v)
+	return a.neighbors.Remove(v)
+}
+
+// AdjacencyList is the adjacency list implementation of Graph. Every vertex keeps the set of its neighbors, making
+// it suitable for sparse graphs: it takes O(|V| + |E|) space and edge lookups, insertions and removals take O(1)
+// time. Vertices and neighbors are traversed in insertion order.
+type AdjacencyList[V comparable, W any] struct {
+	directed bool
+	vertices *set.LinkedHashSet[V]
+	outgoing map[V]*adjacency[V, W]
+	// incoming is only populated for directed graphs; undirected graphs rely on outgoing for both directions.
+	incoming map[V]*adjacency[V, W]
+	size     int
+}
+
+var _ Graph[string, int] = &AdjacencyList[string, int]{}
+
+// NewAdjacencyList allocates a new AdjacencyList instance.
+func NewAdjacencyList[V comparable, W any](directed bool) *AdjacencyList[V, W] {
+	return &AdjacencyList[V, W]{
+		directed: directed,
+		vertices: set.NewLinkedHashSet[V](),
+		outgoing: make(map[V]*adjacency[V, W]),
+		incoming: make(map[V]*adjacency[V, W]),
+	}
+}
+
+// IsDirected returns true if edges of this graph have a direction.
+func (a *AdjacencyList[V, W]) IsDirected() bool {
+	return a.directed
+}
+
+// AddVertex adds a vertex into this graph. Returns false if the vertex was already present.
+func (a *AdjacencyList[V, W]) AddVertex(v V) bool {
+	if !a.vertices.Add(v) {
+		return false
+	}
+	a.outgoing[v] = newAdjacency[V, W]()
+	if a.directed {
+		a.incoming[v] = newAdjacency[V, W]()
+	}
+	return true
+}
+
+// RemoveVertex removes a vertex and all of its edges from this graph.
+func (a *AdjacencyList[V, W]) RemoveVertex(v V) bool {
+	if !a.vertices.Contains(v) {
+		return false
+	}
+
+	for _, neighbor := range a.outgoing[v].neighbors.ToSlice() {
+		a.RemoveEdge(v, neighbor)
+	}
+	if a.directed {
+		for _, neighbor := range a.incoming[v].neighbors.ToSlice() {
+			a.RemoveEdge(neighbor, v)
+		}
+		delete(a.incoming, v)
+	}
+	delete(a.outgoing, v)
+	a.vertices.Remove(v)
+	return true
+}
+
+// ContainsVertex returns true if this graph contains the specified vertex.
+func (a *AdjacencyList[V, W]) ContainsVertex(v V) bool {
+	return a.vertices.Contains(v)
+}
+
+// AddEdge adds an edge with a zero-value weight between the specified vertices, adding missing vertices.
+// Returns false if the edge was already present.
+func (a *AdjacencyList[V, W]) AddEdge(from, to V) bool {
+	var zeroWeight W
+	return a.AddWeightedEdge(from, to, zeroWeight)
+}
+
+// AddWeightedEdge adds an edge with the specified weight between the specified vertices, adding missing vertices.
+// If the edge was already present, its weight is replaced and false is returned.
+func (a *AdjacencyList[V, W]) AddWeightedEdge(from, to V, weight W) bool {
+	a.AddVertex(from)
+	a.AddVertex(to)
+	wasAdded := a.outgoing[from].put(to, weight)
+	if a.directed {
+		a.incoming[to].put(from, weight)
+	} else {
+		a.outgoing[to].put(from, weight)
+	}
+	if wasAdded {
+		a.size++
+	}
+	return wasAdded
+}
+
+// RemoveEdge removes the edge between the specified vertices.
+func (a *AdjacencyList[V, W]) RemoveEdge(from, to V) bool {
+	adj, ok := a.outgoing[from]
+	if !ok || !adj.remove(to) {
+		return false
+	}
+	if a.directed {
+		a.incoming[to].remove(from)
+	} else {
+		a.outgoing[to].remove(from)
+	}
+	a.size--
+	return true
+}
+
+// ContainsEdge returns true if this graph contains an edge between the specified vertices.
+func (a *AdjacencyList[V, W]) ContainsEdge(from, to V) bool {
+	adj, ok := a.outgoing[from]
+	return ok && adj.neighbors.Contains(to)
+}
+
+// Weight returns the weight of the edge between the specified vertices.
+func (a *AdjacencyList[V, W]) Weight(from, to V) (W, bool) {
+	adj, ok := a.outgoing[from]
+	if !ok {
+		var zeroWeight W
+		return zeroWeight, false
+	}
+	weight, ok := adj.weights[to]
+	return weight, ok
+}
+
+// Vertices returns a collection.Collection view of the vertices of this graph, in insertion order.
+func (a *AdjacencyList[V, W]) Vertices() collection.Collection[V] {
+	return list.NewSliceList(a.vertices.ToSlice())
+}
+
+// Edges returns a collection.Collection view of the edges of this graph. Undirected edges are returned once.
+func (a *AdjacencyList[V, W]) Edges() collection.Collection[Edge[V, W]] {
+	buf := make([]Edge[V, W], 0, a.size)
+	// visited holds vertices whose edges were already collected, used to skip mirrored undirected edges
+	visited := make(map[V]struct{}, a.vertices.Len())
+	a.vertices.ForEach(func(from V) bool {
+		adj := a.outgoing[from]
+		adj.neighbors.ForEach(func(to V) bool {
+			if _, ok := visited[to]; !ok || a.directed {
+				buf = append(buf, Edge[V, W]{From: from, To: to, Weight: adj.weights[to]})
+			}
+			return false
+		})
+		visited[from] = struct{}{}
+		return false
+	})
+	return list.NewSliceList(buf)
+}
+
+// Neighbors returns a collection.Collection view of the vertices reachable from v through a single edge,
+// in insertion order.
+func (a *AdjacencyList[V, W]) Neighbors(v V) collection.Collection[V] {
+	adj, ok := a.outgoing[v]
+	if !ok {
+		return list.NewSliceList[V](nil)
+	}
+	return list.NewSliceList(adj.neighbors.ToSlice())
+}
+
+// InDegree returns the number of edges pointing to v. Equals OutDegree for undirected graphs.
+func (a *AdjacencyList[V, W]) InDegree(v V) int {
+	if !a.directed {
+		return a.OutDegree(v)
+	}
+	adj, ok := a.incoming[v]
+	if !ok {
+		return 0
+	}
+	return adj.neighbors.Len()
+}
+
+// OutDegree returns the number of edges going out of v. Equals InDegree for undirected graphs.
+func (a *AdjacencyList[V, W]) OutDegree(v V) int {
+	adj, ok := a.outgoing[v]
+	if !ok {
+		return 0
+	}
+	return adj.neighbors.Len()
+}
+
+// Order returns the number of vertices in this graph.
+func (a *AdjacencyList[V, W]) Order() int {
+	return a.vertices.Len()
+}
+
+// Size returns the number of edges in this graph.
+func (a *AdjacencyList[V, W]) Size() int {
+	return a.size
+}
@@ -0,0 +1,244 @@
+package graph
+
+import (
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+)
+
+// matrixCell is a structure used to hold the weight of an edge within an AdjacencyMatrix.
+type matrixCell[W any] struct {
+	weight  W
+	present bool
+}
+
+// AdjacencyMatrix is the adjacency matrix implementation of Graph. It keeps a |V|x|V| matrix where each cell tells
+// whether an edge connects two vertices, making it suitable for dense graphs: it takes O(|V|^2) space, edge lookups,
+// insertions and removals take O(1) time whereas neighbor and degree queries take O(|V|) time.
+// Adding a vertex takes O(|V|) amortized time and removing one takes O(|V|^2) time.
+//
+// Vertices are traversed in insertion order, and so are neighbors (i.e., in vertex order rather than in the order their
+// edges were added).
+type AdjacencyMatrix[V comparable, W any] struct {
+	directed bool
+	indexes  map[V]int
+	vertices []V
+	matrix   [][]matrixCell[W]
+	size     int
+}
+
+var _ Graph[string, int] = &AdjacencyMatrix[string, int]{}
+
+// NewAdjacencyMatrix allocates a new AdjacencyMatrix instance.
+func NewAdjacencyMatrix[V comparable, W any](directed bool) *AdjacencyMatrix[V, W] {
+	return &AdjacencyMatrix[V, W]{
+		directed: directed,
+		indexes:  make(map[V]int),
+	}
+}
+
+// NewAdjacencyMatrixFromVertices allocates a new AdjacencyMatrix instance pre-allocating the matrix for the
+// given vertices.
+func NewAdjacencyMatrixFromVertices[V comparable, W any](directed bool, vertices ...V) *AdjacencyMatrix[V, W] {
+	m := &AdjacencyMatrix[V, W]{
+		directed: directed,
+		indexes:  make(map[V]int, len(vertices)),
+		vertices: make([]V, 0, len(vertices)),
+		matrix:   make([][]matrixCell[W], 0, len(vertices)),
+	}
+	for _, v := range vertices {
+		m.AddVertex(v)
+	}
+	return m
+}
+
+func (m *AdjacencyMatrix[V, W]) cell(from, to V) *matrixCell[W] {
+	fromIndex, ok := m.indexes[from]
+	if !ok {
+		return nil
+	}
+	toIndex, ok := m.indexes[to]
+	if !ok {
+		return nil
+	}
+	return &m.matrix[fromIndex][toIndex]
+}
+
+// IsDirected returns true if edges of this graph have a direction.
+func (m *AdjacencyMatrix[V, W]) IsDirected() bool {
+	return m.directed
+}
+
+// AddVertex adds a vertex into this graph. Returns false if the vertex was already present.
+func (m *AdjacencyMatrix[V, W]) AddVertex(v V) bool {
+	if _, ok := m.indexes[v]; ok {
+		return false
+	}
+
+	m.indexes[v] = len(m.vertices)
+	m.vertices = append(m.vertices, v)
+	for i := range m.matrix {
+		m.matrix[i] = append(m.matrix[i], matrixCell[W]{})
+	}
+	m.matrix = append(m.matrix, make([]matrixCell[W], len(m.vertices)))
+	return true
+}
+
+// RemoveVertex removes a vertex and all of its edges from this graph.
+func (m *AdjacencyMatrix[V, W]) RemoveVertex(v V) bool {
+	index, ok := m.indexes[v]
+	if !ok {
+		return false
+	}
+
+	for i := range m.vertices {
+		m.RemoveEdge(v, m.vertices[i])
+		m.RemoveEdge(m.vertices[i], v)
+	}
+	m.matrix = append(m.matrix[:index], m.matrix[index+1:]...)
+	for i := range m.matrix {
+		m.matrix[i] = append(m.matrix[i][:index], m.matrix[i][index+1:]...)
+	}
+	m.vertices = append(m.vertices[:index], m.vertices[index+1:]...)
+	delete(m.indexes, v)
+	for i := index; i < len(m.vertices); i++ {
+		m.indexes[m.vertices[i]] = i
+	}
+	return true
+}
+
+// ContainsVertex returns true if this graph contains the specified vertex.
+func (m *AdjacencyMatrix[V, W]) ContainsVertex(v V) bool {
+	_, ok := m.indexes[v]
+	return ok
+}
+
+// AddEdge adds an edge with a zero-value weight between the specified vertices, adding missing vertices.
+// Returns false if the edge was already present.
+func (m *AdjacencyMatrix[V, W]) AddEdge(from, to V) bool {
+	var zeroWeight W
+	return m.AddWeightedEdge(from, to, zeroWeight)
+}
+
+// AddWeightedEdge adds an edge with the specified weight between the specified vertices, adding missing vertices.
+// If the edge was already present, its weight is replaced and false is returned.
+func (m *AdjacencyMatrix[V, W]) AddWeightedEdge(from, to V, weight W) bool {
+	m.AddVertex(from)
+	m.AddVertex(to)
+	cell := m.cell(from, to)
+	wasAdded := !cell.present
+	*cell = matrixCell[W]{weight: weight, present: true}
+	if !m.directed {
+		*m.cell(to, from) = matrixCell[W]{weight: weight, present: true}
+	}
+	if wasAdded {
+		m.size++
+	}
+	return wasAdded
+}
+
+// RemoveEdge removes the edge between the specified vertices.
+func (m *AdjacencyMatrix[V, W]) RemoveEdge(from, to V) bool {
+	cell := m.cell(from, to)
+	if cell == nil || !cell.present {
+		return false
+	}
+	*cell = matrixCell[W]{}
+	if !m.directed {
+		*m.cell(to, from) = matrixCell[W]{}
+	}
+	m.size--
+	return true
+}
+
+// ContainsEdge returns true if this graph contains an edge between the specified vertices.
+func (m *AdjacencyMatrix[V, W]) ContainsEdge(from, to V) bool {
+	cell := m.cell(from, to)
+	return cell != nil && cell.present
+}
+
+// Weight returns the weight of the edge between the specified vertices.
+func (m *AdjacencyMatrix[V, W]) Weight(from, to V) (W, bool) {
+	cell := m.cell(from, to)
+	if cell == nil || !cell.present {
+		var zeroWeight W
+		return zeroWeight, false
+	}
+	return cell.weight, true
+}
+
+// Vertices returns a collection.Collection view of the vertices of this graph, in insertion order.
+func (m *AdjacencyMatrix[V, W]) Vertices() collection.Collection[V] {
+	buf := make([]V, len(m.vertices))
+	copy(buf, m.vertices)
+	return list.NewSliceList(buf)
+}
+
+// Edges returns a collection.Collection view of the edges of this graph. Undirected edges are returned once.
+func (m *AdjacencyMatrix[V, W]) Edges() collection.Collection[Edge[V, W]] {
+	buf := make([]Edge[V, W], 0, m.size)
+	for i, row := range m.matrix {
+		for j, cell := range row {
+			if !cell.present || (!m.directed && j < i) {
+				continue
+			}
+			buf = append(buf, Edge[V, W]{From: m.vertices[i], To: m.vertices[j], Weight: cell.weight})
+		}
+	}
+	return list.NewSliceList(buf)
+}
+
+// Neighbors returns a collection.Collection view of the vertices reachable from v through a single edge,
+// in vertex order (i.e., the order of Vertices), regardless of the order edges were added in.
+func (m *AdjacencyMatrix[V, W]) Neighbors(v V) collection.Collection[V] {
+	index, ok := m.indexes[v]
+	if !ok {
+		return list.NewSliceList[V](nil)
+	}
+	buf := make([]V, 0)
+	for j, cell := range m.matrix[index] {
+		if cell.present {
+			buf = append(buf, m.vertices[j])
+		}
+	}
+	return list.NewSliceList(buf)
+}
+
+// InDegree returns the number of edges pointing to v. Equals OutDegree for undirected graphs.
+func (m *AdjacencyMatrix[V, W]) InDegree(v V) int {
+	index, ok := m.indexes[v]
+	if !ok {
+		return 0
+	}
+	degree := 0
+	for _, row := range m.matrix {
+		if row[index].present {
+			degree++
+		}
+	}
+	return degree
+}
+
+// OutDegree returns the number of edges going out of v. Equals InDegree for undirected graphs.
+func (m *AdjacencyMatrix[V, W]) OutDegree(v V) int {
+	index, ok := m.indexes[v]
+	if !ok {
+		return 0
+	}
+	degree := 0
+	for _, cell := range m.matrix[index] {
+		if cell.present {
+			degree++
+		}
+	}
+	return degree
+}
+
+// Order returns the number of vertices in this graph.
+func (m *AdjacencyMatrix[V, W]) Order() int {
+	return len(m.vertices)
+}
+
+// Size returns the number of edges in this graph.
+func (m *AdjacencyMatrix[V, W]) Size() int {
+	return m.size
+}
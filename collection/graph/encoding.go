@@ -0,0 +1,360 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/neutrinocorp/nolan/function"
+)
+
+// Attributes holds the key-value pairs describing a vertex or an edge when encoding a Graph (e.g., DOT's label or
+// color, GraphML data).
+type Attributes map[string]string
+
+// VertexIDFunc is a function.DelegateFunc type used to get the identifier of a vertex within an encoded Graph.
+type VertexIDFunc[V comparable] function.DelegateFunc[V, string]
+
+// VertexAttributesFunc is a function.DelegateFunc type used to get the Attributes of a vertex.
+type VertexAttributesFunc[V comparable] function.DelegateFunc[V, Attributes]
+
+// EdgeAttributesFunc is a function.DelegateFunc type used to get the Attributes of an edge.
+type EdgeAttributesFunc[V comparable, W any] function.DelegateFunc[Edge[V, W], Attributes]
+
+// EncoderOptions holds the settings of graph encoders (e.g., EncodeDOT, EncodeGraphML). Every field is optional.
+type EncoderOptions[V comparable, W any] struct {
+	// Name is the identifier of the encoded graph.
+	Name string
+	// VertexIDFunc returns the identifier of a vertex. Defaults to fmt.Sprint; identifiers must be unique.
+	VertexIDFunc VertexIDFunc[V]
+	// VertexAttributesFunc returns the attributes of a vertex. No attributes are written if nil.
+	VertexAttributesFunc VertexAttributesFunc[V]
+	// EdgeAttributesFunc returns the attributes of an edge. No attributes are written if nil.
+	EdgeAttributesFunc EdgeAttributesFunc[V, W]
+}
+
+func (o EncoderOptions[V, W]) vertexID(v V) string {
+	if o.VertexIDFunc == nil {
+		return fmt.Sprint(v)
+	}
+	return o.VertexIDFunc(v)
+}
+
+func (o EncoderOptions[V, W]) vertexAttributes(v V) Attributes {
+	if o.VertexAttributesFunc == nil {
+		return nil
+	}
+	return o.VertexAttributesFunc(v)
+}
+
+func (o EncoderOptions[V, W]) edgeAttributes(edge Edge[V, W]) Attributes {
+	if o.EdgeAttributesFunc == nil {
+		return nil
+	}
+	return o.EdgeAttributesFunc(edge)
+}
+
+// sortedKeys returns the keys of attrs in ascending order, so encoders yield deterministic outputs.
+func sortedKeys(attrs Attributes) []string {
+	keys := make([]string, 0, len(attrs))
+	for key := range attrs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var dotReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// quoteDOT returns s as a DOT double-quoted string.
+func quoteDOT(s string) string {
+	return `"` + dotReplacer.Replace(s) + `"`
+}
+
+// dotKeywords holds the reserved words of the DOT language, which are matched case-insensitively.
+var dotKeywords = map[string]struct{}{
+	"node":     {},
+	"edge":     {},
+	"graph":    {},
+	"digraph":  {},
+	"subgraph": {},
+	"strict":   {},
+}
+
+// isDOTIdentifier returns true if s can be written unquoted as a DOT ID (i.e., an alphanumeric string not starting
+// with a digit which is not a keyword).
+func isDOTIdentifier(s string) bool {
+	if _, ok := dotKeywords[strings.ToLower(s)]; ok {
+		return false
+	}
+	for i, c := range s {
+		isLetter := c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+		if !isLetter && (i == 0 || c < '0' || c > '9') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func writeDOTAttributes(buf *bytes.Buffer, attrs Attributes) {
+	if len(attrs) == 0 {
+		return
+	}
+	buf.WriteString(" [")
+	for i, key := range sortedKeys(attrs) {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		if isDOTIdentifier(key) {
+			buf.WriteString(key)
+		} else {
+			buf.WriteString(quoteDOT(key))
+		}
+		buf.WriteByte('=')
+		buf.WriteString(quoteDOT(attrs[key]))
+	}
+	buf.WriteByte(']')
+}
+
+// EncodeDOT writes a graph to w using the Graphviz DOT language. Every vertex is declared (so isolated ones are kept),
+// followed by every edge; attributes are written in ascending key order.
+func EncodeDOT[V comparable, W any](w io.Writer, g Graph[V, W], opts EncoderOptions[V, W]) error {
+	buf := bytes.Buffer{}
+	edgeOp := " -- "
+	if g.IsDirected() {
+		buf.WriteString("digraph ")
+		edgeOp = " -> "
+	} else {
+		buf.WriteString("graph ")
+	}
+	if opts.Name != "" {
+		buf.WriteString(quoteDOT(opts.Name))
+		buf.WriteByte(' ')
+	}
+	buf.WriteString("{\n")
+
+	g.Vertices().ForEach(func(v V) bool {
+		buf.WriteByte('\t')
+		buf.WriteString(quoteDOT(opts.vertexID(v)))
+		writeDOTAttributes(&buf, opts.vertexAttributes(v))
+		buf.WriteString(";\n")
+		return false
+	})
+	g.Edges().ForEach(func(edge Edge[V, W]) bool {
+		buf.WriteByte('\t')
+		buf.WriteString(quoteDOT(opts.vertexID(edge.From)))
+		buf.WriteString(edgeOp)
+		buf.WriteString(quoteDOT(opts.vertexID(edge.To)))
+		writeDOTAttributes(&buf, opts.edgeAttributes(edge))
+		buf.WriteString(";\n")
+		return false
+	})
+	buf.WriteString("}\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+const graphMLNamespace = "http://graphml.graphdrawing.org/xmlns"
+
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// graphMLKeys is a structure used to collect the keys (i.e., attribute declarations) of a GraphML document domain.
+// Data elements hold attribute names until declare assigns an ID to every key; resolve replaces them afterwards.
+type graphMLKeys struct {
+	domain string
+	ids    map[string]string
+}
+
+func (k graphMLKeys) data(attrs Attributes) []graphMLData {
+	if len(attrs) == 0 {
+		return nil
+	}
+	buf := make([]graphMLData, 0, len(attrs))
+	for _, name := range sortedKeys(attrs) {
+		k.ids[name] = ""
+		buf = append(buf, graphMLData{Key: name, Value: attrs[name]})
+	}
+	return buf
+}
+
+// declare assigns an opaque ID (i.e., d<n>) to every collected key in ascending name order, starting at offset.
+func (k graphMLKeys) declare(offset int) []graphMLKey {
+	names := make([]string, 0, len(k.ids))
+	for name := range k.ids {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	buf := make([]graphMLKey, 0, len(names))
+	for i, name := range names {
+		k.ids[name] = "d" + strconv.Itoa(offset+i)
+		buf = append(buf, graphMLKey{ID: k.ids[name], For: k.domain, AttrName: name, AttrType: "string"})
+	}
+	return buf
+}
+
+func (k graphMLKeys) resolve(data []graphMLData) {
+	for i := range data {
+		data[i].Key = k.ids[data[i].Key]
+	}
+}
+
+// EncodeGraphML writes a graph to w as a GraphML document. Attributes are declared as string keys with opaque IDs
+// (i.e., d0, d1, ...), node keys first; attribute names are kept in attr.name.
+func EncodeGraphML[V comparable, W any](w io.Writer, g Graph[V, W], opts EncoderOptions[V, W]) error {
+	nodeKeys := graphMLKeys{domain: "node", ids: make(map[string]string)}
+	edgeKeys := graphMLKeys{domain: "edge", ids: make(map[string]string)}
+	doc := graphMLDocument{
+		XMLNS: graphMLNamespace,
+		Graph: graphMLGraph{
+			ID:          opts.Name,
+			EdgeDefault: "undirected",
+			Nodes:       make([]graphMLNode, 0, g.Order()),
+			Edges:       make([]graphMLEdge, 0, g.Size()),
+		},
+	}
+	if doc.Graph.ID == "" {
+		doc.Graph.ID = "G"
+	}
+	if g.IsDirected() {
+		doc.Graph.EdgeDefault = "directed"
+	}
+
+	g.Vertices().ForEach(func(v V) bool {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			ID:   opts.vertexID(v),
+			Data: nodeKeys.data(opts.vertexAttributes(v)),
+		})
+		return false
+	})
+	g.Edges().ForEach(func(edge Edge[V, W]) bool {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			Source: opts.vertexID(edge.From),
+			Target: opts.vertexID(edge.To),
+			Data:   edgeKeys.data(opts.edgeAttributes(edge)),
+		})
+		return false
+	})
+	nodeDecls := nodeKeys.declare(0)
+	doc.Keys = append(nodeDecls, edgeKeys.declare(len(nodeDecls))...)
+	for _, node := range doc.Graph.Nodes {
+		nodeKeys.resolve(node.Data)
+	}
+	for _, edge := range doc.Graph.Edges {
+		edgeKeys.resolve(edge.Data)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// jsonAdjacencyDocument is the JSON representation of a Graph used by EncodeJSON and DecodeJSON.
+type jsonAdjacencyDocument[V comparable, W any] struct {
+	Directed  bool                       `json:"directed"`
+	Adjacency []jsonAdjacencyEntry[V, W] `json:"adjacency"`
+}
+
+type jsonAdjacencyEntry[V comparable, W any] struct {
+	Vertex    V                             `json:"vertex"`
+	Neighbors []jsonAdjacencyNeighbor[V, W] `json:"neighbors"`
+}
+
+type jsonAdjacencyNeighbor[V comparable, W any] struct {
+	Vertex V `json:"vertex"`
+	Weight W `json:"weight"`
+}
+
+// EncodeJSON writes a graph to w as a JSON adjacency list, where every vertex is listed along with its neighbors and
+// the weights of the edges leading to them. Vertices and weights are encoded with encoding/json.
+//
+// e.g. {"directed":true,"adjacency":[{"vertex":"a","neighbors":[{"vertex":"b","weight":1}]}]}
+func EncodeJSON[V comparable, W any](w io.Writer, g Graph[V, W]) error {
+	doc := jsonAdjacencyDocument[V, W]{
+		Directed:  g.IsDirected(),
+		Adjacency: make([]jsonAdjacencyEntry[V, W], 0, g.Order()),
+	}
+	g.Vertices().ForEach(func(v V) bool {
+		entry := jsonAdjacencyEntry[V, W]{
+			Vertex:    v,
+			Neighbors: make([]jsonAdjacencyNeighbor[V, W], 0, g.OutDegree(v)),
+		}
+		forEachOutgoing(g, v, func(edge Edge[V, W]) bool {
+			entry.Neighbors = append(entry.Neighbors, jsonAdjacencyNeighbor[V, W]{Vertex: edge.To, Weight: edge.Weight})
+			return false
+		})
+		doc.Adjacency = append(doc.Adjacency, entry)
+		return false
+	})
+	return json.NewEncoder(w).Encode(doc)
+}
+
+// DecodeJSON reads a JSON adjacency list written by EncodeJSON from r, loading it into the graph returned by
+// factoryFunc (e.g., NewAdjacencyList) for the document's directedness.
+func DecodeJSON[V comparable, W any](r io.Reader,
+	factoryFunc func(directed bool) Graph[V, W]) (Graph[V, W], error) {
+	if factoryFunc == nil {
+		panic("factoryFunc cannot be nil")
+	}
+
+	doc := jsonAdjacencyDocument[V, W]{}
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+	g := factoryFunc(doc.Directed)
+	for _, entry := range doc.Adjacency {
+		g.AddVertex(entry.Vertex)
+	}
+	for _, entry := range doc.Adjacency {
+		for _, neighbor := range entry.Neighbors {
+			g.AddWeightedEdge(entry.Vertex, neighbor.Vertex, neighbor.Weight)
+		}
+	}
+	return g, nil
+}
@@ -0,0 +1,180 @@
+package graph_test
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutrinocorp/nolan/collection/graph"
+)
+
+func newServiceGraph(directed bool) graph.Graph[string, int] {
+	g := graph.NewAdjacencyList[string, int](directed)
+	g.AddWeightedEdge("api", "db", 3)
+	g.AddWeightedEdge("api", `cache "hot"`, 1)
+	g.AddVertex("worker")
+	return g
+}
+
+var serviceGraphOptions = graph.EncoderOptions[string, int]{
+	Name: "services",
+	VertexAttributesFunc: func(v string) graph.Attributes {
+		if v == "api" {
+			return graph.Attributes{"shape": "box", "color": "red"}
+		}
+		return nil
+	},
+	EdgeAttributesFunc: func(edge graph.Edge[string, int]) graph.Attributes {
+		return graph.Attributes{"weight": strconv.Itoa(edge.Weight)}
+	},
+}
+
+func TestEncodeDOT(t *testing.T) {
+	tests := []struct {
+		name     string
+		directed bool
+		opts     graph.EncoderOptions[string, int]
+		exp      string
+	}{
+		{
+			name:     "directed with attributes",
+			directed: true,
+			opts:     serviceGraphOptions,
+			exp: `digraph "services" {
+	"api" [color="red", shape="box"];
+	"db";
+	"cache \"hot\"";
+	"worker";
+	"api" -> "db" [weight="3"];
+	"api" -> "cache \"hot\"" [weight="1"];
+}
+`,
+		},
+		{
+			name: "undirected without options",
+			exp: `graph {
+	"api";
+	"db";
+	"cache \"hot\"";
+	"worker";
+	"api" -- "db";
+	"api" -- "cache \"hot\"";
+}
+`,
+		},
+		{
+			name: "keyword attribute names",
+			opts: graph.EncoderOptions[string, int]{
+				VertexAttributesFunc: func(v string) graph.Attributes {
+					if v == "api" {
+						return graph.Attributes{"Node": "x", "label": "y", "fill color": "z"}
+					}
+					return nil
+				},
+				EdgeAttributesFunc: func(edge graph.Edge[string, int]) graph.Attributes {
+					return graph.Attributes{"STRICT": strconv.Itoa(edge.Weight)}
+				},
+			},
+			exp: `graph {
+	"api" ["Node"="x", "fill color"="z", label="y"];
+	"db";
+	"cache \"hot\"";
+	"worker";
+	"api" -- "db" ["STRICT"="3"];
+	"api" -- "cache \"hot\"" ["STRICT"="1"];
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			require.NoError(t, graph.EncodeDOT(&buf, newServiceGraph(tt.directed), tt.opts))
+			assert.Equal(t, tt.exp, buf.String())
+		})
+	}
+}
+
+func TestEncodeGraphML(t *testing.T) {
+	buf := bytes.Buffer{}
+	require.NoError(t, graph.EncodeGraphML(&buf, newServiceGraph(true), serviceGraphOptions))
+	exp := `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+  <key id="d0" for="node" attr.name="color" attr.type="string"></key>
+  <key id="d1" for="node" attr.name="shape" attr.type="string"></key>
+  <key id="d2" for="edge" attr.name="weight" attr.type="string"></key>
+  <graph id="services" edgedefault="directed">
+    <node id="api">
+      <data key="d0">red</data>
+      <data key="d1">box</data>
+    </node>
+    <node id="db"></node>
+    <node id="cache &#34;hot&#34;"></node>
+    <node id="worker"></node>
+    <edge source="api" target="db">
+      <data key="d2">3</data>
+    </edge>
+    <edge source="api" target="cache &#34;hot&#34;">
+      <data key="d2">1</data>
+    </edge>
+  </graph>
+</graphml>
+`
+	assert.Equal(t, exp, buf.String())
+}
+
+func TestEncodeGraphML_KeyIDs(t *testing.T) {
+	buf := bytes.Buffer{}
+	require.NoError(t, graph.EncodeGraphML(&buf, newServiceGraph(true), graph.EncoderOptions[string, int]{
+		VertexAttributesFunc: func(v string) graph.Attributes {
+			return graph.Attributes{"fill color": v, "weight": "0"}
+		},
+		EdgeAttributesFunc: func(edge graph.Edge[string, int]) graph.Attributes {
+			return graph.Attributes{"weight": strconv.Itoa(edge.Weight)}
+		},
+	}))
+	out := buf.String()
+	assert.Contains(t, out, `<key id="d0" for="node" attr.name="fill color" attr.type="string"></key>`)
+	assert.Contains(t, out, `<key id="d1" for="node" attr.name="weight" attr.type="string"></key>`)
+	assert.Contains(t, out, `<key id="d2" for="edge" attr.name="weight" attr.type="string"></key>`)
+	assert.Contains(t, out, `<data key="d0">worker</data>`)
+	assert.Contains(t, out, `<data key="d2">3</data>`)
+}
+
+func TestJSON_RoundTrip(t *testing.T) {
+	for _, factory := range graphFactories {
+		for _, directed := range []bool{true, false} {
+			t.Run(factory.name+" directed="+strconv.FormatBool(directed), func(t *testing.T) {
+				src := newServiceGraph(directed)
+				buf := bytes.Buffer{}
+				require.NoError(t, graph.EncodeJSON(&buf, src))
+
+				dst, err := graph.DecodeJSON[string, int](&buf, factory.factoryFunc)
+				require.NoError(t, err)
+				assert.Equal(t, directed, dst.IsDirected())
+				assert.Equal(t, src.Vertices().ToSlice(), dst.Vertices().ToSlice())
+				assert.Equal(t, src.Edges().ToSlice(), dst.Edges().ToSlice())
+			})
+		}
+	}
+}
+
+func TestJSON_Format(t *testing.T) {
+	g := graph.NewAdjacencyList[int, float64](true)
+	g.AddWeightedEdge(1, 2, 0.5)
+	g.AddVertex(3)
+	buf := bytes.Buffer{}
+	require.NoError(t, graph.EncodeJSON(&buf, g))
+	assert.Equal(t, `{"directed":true,"adjacency":[{"vertex":1,"neighbors":[{"vertex":2,"weight":0.5}]},`+
+		`{"vertex":2,"neighbors":[]},{"vertex":3,"neighbors":[]}]}`+"\n", buf.String())
+
+	_, err := graph.DecodeJSON[int, float64](strings.NewReader(`{"directed":`), func(directed bool) graph.Graph[int, float64] {
+		return graph.NewAdjacencyList[int, float64](directed)
+	})
+	assert.Error(t, err)
+}
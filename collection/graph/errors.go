@@ -0,0 +1,14 @@
+package graph
+
+import "errors"
+
+var (
+	ErrVertexNotFound  = errors.New("nolan.graph: vertex not found")
+	ErrNegativeWeight  = errors.New("nolan.graph: negative edge weight")
+	ErrNegativeCycle   = errors.New("nolan.graph: negative cycle detected")
+	ErrCycle           = errors.New("nolan.graph: cycle detected")
+	ErrDirectedGraph   = errors.New("nolan.graph: operation not supported on directed graphs")
+	ErrUndirectedGraph = errors.New("nolan.graph: operation not supported on undirected graphs")
+	ErrSourceIsSink    = errors.New("nolan.graph: source and sink are the same vertex")
+	ErrNotBipartite    = errors.New("nolan.graph: graph is not bipartite")
+)
@@ -0,0 +1,310 @@
+package graph
+
+import (
+	"github.com/neutrinocorp/nolan/collection/maps"
+)
+
+// flowNetwork is an index-based residual network. Arcs are stored in pairs: arc i^1 is the reverse of arc i, so
+// pushing flow through an arc frees the same amount of capacity on its reverse.
+type flowNetwork[D Number] struct {
+	// heads holds the indexes of the arcs leaving every node.
+	heads      [][]int
+	from       []int
+	to         []int
+	capacities []D
+	residuals  []D
+	// levels and nextArcs hold the state of Dinic's current phase.
+	levels   []int
+	nextArcs []int
+}
+
+func newFlowNetwork[D Number](nodeCount int) *flowNetwork[D] {
+	return &flowNetwork[D]{
+		heads:    make([][]int, nodeCount),
+		levels:   make([]int, nodeCount),
+		nextArcs: make([]int, nodeCount),
+	}
+}
+
+// addArc adds an arc along with its reverse, returning the index of the former. Undirected edges have a reverse
+// capacity equal to their capacity; directed ones have none.
+func (n *flowNetwork[D]) addArc(from, to int, capacity, reverseCapacity D) int {
+	index := len(n.to)
+	n.heads[from] = append(n.heads[from], index)
+	n.heads[to] = append(n.heads[to], index+1)
+	n.from = append(n.from, from, to)
+	n.to = append(n.to, to, from)
+	n.capacities = append(n.capacities, capacity, reverseCapacity)
+	n.residuals = append(n.residuals, capacity, reverseCapacity)
+	return index
+}
+
+// maxFlow saturates the network from source to sink using Dinic's algorithm, returning the value of the flow.
+// Takes O(|V|^2 |E|) time, O(|E| sqrt(|V|)) for unit-capacity networks such as bipartite matchings.
+func (n *flowNetwork[D]) maxFlow(source, sink int) D {
+	var total, limit D
+	for _, arc := range n.heads[source] {
+		limit += n.residuals[arc]
+	}
+	for n.buildLevels(source, sink) {
+		clear(n.nextArcs)
+		for {
+			pushed := n.augment(source, sink, limit)
+			if pushed <= 0 {
+				break
+			}
+			total += pushed
+		}
+	}
+	return total
+}
+
+// buildLevels labels every node with its distance from source in the residual network.
+// Returns false if sink is unreachable (i.e., the flow is maximum).
+func (n *flowNetwork[D]) buildLevels(source, sink int) bool {
+	for i := range n.levels {
+		n.levels[i] = -1
+	}
+	n.levels[source] = 0
+	queue := []int{source}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, arc := range n.heads[current] {
+			if next := n.to[arc]; n.residuals[arc] > 0 && n.levels[next] < 0 {
+				n.levels[next] = n.levels[current] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return n.levels[sink] >= 0
+}
+
+// augment pushes up to limit units of flow from node to sink along a path of strictly increasing levels,
+// returning the amount pushed. Exhausted arcs are skipped for the rest of the phase.
+func (n *flowNetwork[D]) augment(node, sink int, limit D) D {
+	if node == sink {
+		return limit
+	}
+	for ; n.nextArcs[node] < len(n.heads[node]); n.nextArcs[node]++ {
+		arc := n.heads[node][n.nextArcs[node]]
+		next := n.to[arc]
+		if n.residuals[arc] <= 0 || n.levels[next] != n.levels[node]+1 {
+			continue
+		}
+		if pushed := n.augment(next, sink, min(limit, n.residuals[arc])); pushed > 0 {
+			n.residuals[arc] -= pushed
+			n.residuals[arc^1] += pushed
+			return pushed
+		}
+	}
+	return 0
+}
+
+// reachable returns which nodes are reachable from source in the residual network.
+func (n *flowNetwork[D]) reachable(source int) []bool {
+	visited := make([]bool, len(n.heads))
+	visited[source] = true
+	queue := []int{source}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, arc := range n.heads[current] {
+			if next := n.to[arc]; n.residuals[arc] > 0 && !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return visited
+}
+
+// arcKey is a structure used to look up the arc connecting two nodes.
+type arcKey struct {
+	from int
+	to   int
+}
+
+// MaximumFlow holds the result of a maximum flow algorithm, along with the minimum cut it induces.
+type MaximumFlow[V comparable, D Number] struct {
+	// Source is the vertex flow leaves from.
+	Source V
+	// Sink is the vertex flow arrives to.
+	Sink V
+	// Value is the amount of flow going from Source to Sink, which equals the capacity of the minimum cut.
+	Value D
+
+	vertices []V
+	indexes  map[V]int
+	arcs     map[arcKey]int
+	network  *flowNetwork[D]
+	// sourceSide holds the vertices reachable from Source in the residual network.
+	sourceSide []bool
+}
+
+// Flow returns the amount of flow going through the edge from one vertex to another.
+// Returns false if there is no such edge.
+func (f *MaximumFlow[V, D]) Flow(from, to V) (D, bool) {
+	fromIndex, okFrom := f.indexes[from]
+	toIndex, okTo := f.indexes[to]
+	arc, ok := f.arcs[arcKey{from: fromIndex, to: toIndex}]
+	if !okFrom || !okTo || !ok {
+		var zeroVal D
+		return zeroVal, false
+	}
+	// for undirected edges, a negative amount means the flow goes the other way
+	return max(f.network.capacities[arc]-f.network.residuals[arc], 0), true
+}
+
+// MinCut returns the partition of vertices induced by the minimum cut: the vertices reachable from Source in the
+// residual network and the remaining ones, which include Sink. Both sides are ordered as the graph yields them.
+func (f *MaximumFlow[V, D]) MinCut() (sourceSide, sinkSide []V) {
+	for i, vertex := range f.vertices {
+		if f.sourceSide[i] {
+			sourceSide = append(sourceSide, vertex)
+			continue
+		}
+		sinkSide = append(sinkSide, vertex)
+	}
+	return sourceSide, sinkSide
+}
+
+// CutEdges returns the edges crossing the minimum cut from the source side to the sink side, each weighted by its
+// capacity. All of them are saturated and their capacities add up to Value.
+func (f *MaximumFlow[V, D]) CutEdges() []Edge[V, D] {
+	var buf []Edge[V, D]
+	for arc, capacity := range f.network.capacities {
+		from, to := f.network.from[arc], f.network.to[arc]
+		if capacity > 0 && f.sourceSide[from] && !f.sourceSide[to] {
+			buf = append(buf, Edge[V, D]{From: f.vertices[from], To: f.vertices[to], Weight: capacity})
+		}
+	}
+	return buf
+}
+
+// Dinic computes the maximum flow from source to sink, where every edge can carry at most its capacity, using
+// Dinic's algorithm. Undirected edges can carry flow in either direction. Takes O(|V|^2 |E|) time.
+//
+// Returns ErrVertexNotFound if source or sink are not part of the graph, ErrSourceIsSink if both are the same vertex
+// or ErrNegativeWeight if a negative capacity is found.
+func Dinic[V comparable, W any, D Number](g Graph[V, W], source, sink V,
+	capacityFunc WeightFunc[V, W, D]) (*MaximumFlow[V, D], error) {
+	if !g.ContainsVertex(source) || !g.ContainsVertex(sink) {
+		return nil, ErrVertexNotFound
+	} else if source == sink {
+		return nil, ErrSourceIsSink
+	} else if capacityFunc == nil {
+		panic("capacityFunc cannot be nil")
+	}
+
+	vertices := g.Vertices().ToSlice()
+	indexes := make(map[V]int, len(vertices))
+	for i, vertex := range vertices {
+		indexes[vertex] = i
+	}
+	result := &MaximumFlow[V, D]{
+		Source:   source,
+		Sink:     sink,
+		vertices: vertices,
+		indexes:  indexes,
+		arcs:     make(map[arcKey]int),
+		network:  newFlowNetwork[D](len(vertices)),
+	}
+
+	var err error
+	g.Edges().ForEach(func(edge Edge[V, W]) bool {
+		capacity := capacityFunc(edge)
+		if capacity < 0 {
+			err = ErrNegativeWeight
+			return true
+		}
+		var reverseCapacity D
+		if !g.IsDirected() {
+			reverseCapacity = capacity
+		}
+		key := arcKey{from: indexes[edge.From], to: indexes[edge.To]}
+		arc := result.network.addArc(key.from, key.to, capacity, reverseCapacity)
+		result.arcs[key] = arc
+		if !g.IsDirected() {
+			result.arcs[arcKey{from: key.to, to: key.from}] = arc ^ 1
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result.Value = result.network.maxFlow(indexes[source], indexes[sink])
+	result.sourceSide = result.network.reachable(indexes[source])
+	return result, nil
+}
+
+// BipartiteMatching computes a maximum matching between the vertices of left and their neighbors (e.g., tasks and
+// the workers able to run them): the largest set of edges where no two share a vertex. It is solved as a unit-capacity
+// maximum flow with Dinic's algorithm, taking O(|E| sqrt(|V|)) time.
+//
+// The returned map associates every matched vertex of left with its match. Returns ErrVertexNotFound if a vertex of
+// left is not part of the graph or ErrNotBipartite if an edge connects two vertices of left.
+func BipartiteMatching[V comparable, W any](g Graph[V, W], left ...V) (maps.Map[V, V], error) {
+	indexes := make(map[V]int, g.Order())
+	left = append([]V(nil), left...)
+	for i := 0; i < len(left); i++ {
+		if !g.ContainsVertex(left[i]) {
+			return nil, ErrVertexNotFound
+		} else if _, ok := indexes[left[i]]; ok {
+			// drop duplicates so node indexes match positions in left
+			left = append(left[:i], left[i+1:]...)
+			i--
+			continue
+		}
+		indexes[left[i]] = len(indexes)
+	}
+
+	// nodes are laid out as [left..., right..., source, sink]
+	var right []V
+	type candidate struct {
+		from, to int
+	}
+	var candidates []candidate
+	var err error
+	for _, vertex := range left {
+		g.Neighbors(vertex).ForEach(func(neighbor V) bool {
+			index, ok := indexes[neighbor]
+			if !ok {
+				index = len(indexes)
+				indexes[neighbor] = index
+				right = append(right, neighbor)
+			} else if index < len(left) {
+				err = ErrNotBipartite
+				return true
+			}
+			candidates = append(candidates, candidate{from: indexes[vertex], to: index})
+			return false
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	source, sink := len(indexes), len(indexes)+1
+	network := newFlowNetwork[int](len(indexes) + 2)
+	for i := range left {
+		network.addArc(source, i, 1, 0)
+	}
+	for i := range right {
+		network.addArc(len(left)+i, sink, 1, 0)
+	}
+	matchArcs := make([]int, len(candidates))
+	for i, c := range candidates {
+		matchArcs[i] = network.addArc(c.from, c.to, 1, 0)
+	}
+	network.maxFlow(source, sink)
+
+	matches := maps.HashMap[V, V]{}
+	for i, c := range candidates {
+		if network.residuals[matchArcs[i]] == 0 {
+			matches[left[c.from]] = right[c.to-len(left)]
+		}
+	}
+	return matches, nil
+}
@@ -0,0 +1,117 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutrinocorp/nolan/collection/graph"
+)
+
+func TestDinic(t *testing.T) {
+	for _, factory := range graphFactories {
+		t.Run(factory.name, func(t *testing.T) {
+			// CLRS flow network
+			g := factory.factoryFunc(true)
+			g.AddWeightedEdge("s", "v1", 16)
+			g.AddWeightedEdge("s", "v2", 13)
+			g.AddWeightedEdge("v2", "v1", 4)
+			g.AddWeightedEdge("v1", "v3", 12)
+			g.AddWeightedEdge("v3", "v2", 9)
+			g.AddWeightedEdge("v2", "v4", 14)
+			g.AddWeightedEdge("v4", "v3", 7)
+			g.AddWeightedEdge("v3", "t", 20)
+			g.AddWeightedEdge("v4", "t", 4)
+			g.AddVertex("isolated")
+
+			flow, err := graph.Dinic[string, int, int](g, "s", "t", graph.EdgeWeight[string, int])
+			require.NoError(t, err)
+			assert.Equal(t, 23, flow.Value)
+
+			sourceSide, sinkSide := flow.MinCut()
+			assert.Equal(t, []string{"s", "v1", "v2", "v4"}, sourceSide)
+			assert.Equal(t, []string{"v3", "t", "isolated"}, sinkSide)
+			cutCapacity := 0
+			for _, edge := range flow.CutEdges() {
+				cutCapacity += edge.Weight
+				edgeFlow, ok := flow.Flow(edge.From, edge.To)
+				assert.True(t, ok)
+				assert.Equal(t, edge.Weight, edgeFlow)
+			}
+			assert.Equal(t, flow.Value, cutCapacity)
+
+			// flow conservation on every inner vertex
+			for _, vertex := range []string{"v1", "v2", "v3", "v4"} {
+				balance := 0
+				for _, edge := range g.Edges().ToSlice() {
+					edgeFlow, _ := flow.Flow(edge.From, edge.To)
+					if edge.To == vertex {
+						balance += edgeFlow
+					} else if edge.From == vertex {
+						balance -= edgeFlow
+					}
+				}
+				assert.Zero(t, balance, vertex)
+			}
+			_, ok := flow.Flow("t", "s")
+			assert.False(t, ok)
+
+			_, err = graph.Dinic[string, int, int](g, "s", "missing", graph.EdgeWeight[string, int])
+			assert.ErrorIs(t, err, graph.ErrVertexNotFound)
+			_, err = graph.Dinic[string, int, int](g, "s", "s", graph.EdgeWeight[string, int])
+			assert.ErrorIs(t, err, graph.ErrSourceIsSink)
+			g.AddWeightedEdge("s", "t", -1)
+			_, err = graph.Dinic[string, int, int](g, "s", "t", graph.EdgeWeight[string, int])
+			assert.ErrorIs(t, err, graph.ErrNegativeWeight)
+		})
+	}
+}
+
+func TestDinic_Undirected(t *testing.T) {
+	g := graph.NewAdjacencyList[string, float64](false)
+	g.AddWeightedEdge("s", "a", 3)
+	g.AddWeightedEdge("b", "s", 2)
+	g.AddWeightedEdge("a", "b", 1)
+	g.AddWeightedEdge("t", "a", 2)
+	g.AddWeightedEdge("b", "t", 3)
+
+	flow, err := graph.Dinic[string, float64, float64](g, "s", "t", graph.EdgeWeight[string, float64])
+	require.NoError(t, err)
+	assert.Equal(t, 5.0, flow.Value)
+	toT, _ := flow.Flow("b", "t")
+	fromT, _ := flow.Flow("t", "b")
+	assert.Equal(t, 3.0, toT)
+	assert.Zero(t, fromT)
+}
+
+func TestBipartiteMatching(t *testing.T) {
+	for _, factory := range graphFactories {
+		t.Run(factory.name, func(t *testing.T) {
+			g := factory.factoryFunc(true)
+			g.AddEdge("build", "alice")
+			g.AddEdge("build", "bob")
+			g.AddEdge("deploy", "alice")
+			g.AddEdge("review", "alice")
+			g.AddEdge("test", "carol")
+			g.AddEdge("test", "bob")
+
+			matches, err := graph.BipartiteMatching[string, int](g, "build", "deploy", "review", "test", "build")
+			require.NoError(t, err)
+			assert.Equal(t, 3, matches.Len())
+			workers := make(map[string]struct{})
+			matches.ForEach(func(task, worker string) bool {
+				assert.True(t, g.ContainsEdge(task, worker))
+				workers[worker] = struct{}{}
+				return false
+			})
+			assert.Len(t, workers, 3)
+
+			_, err = graph.BipartiteMatching[string, int](g, "build", "missing")
+			assert.ErrorIs(t, err, graph.ErrVertexNotFound)
+			g.AddEdge("test", "build")
+			_, err = graph.BipartiteMatching[string, int](g, "build", "test")
+			assert.ErrorIs(t, err, graph.ErrNotBipartite)
+		})
+	}
+}
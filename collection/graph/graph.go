@@ -0,0 +1,61 @@
+// Package graph provides generic graph structures (e.g., AdjacencyList, AdjacencyMatrix) and the algorithms
+// operating over them.
+package graph
+
+import (
+	"github.com/neutrinocorp/nolan/collection"
+)
+
+// Edge a connection between two vertices of a Graph. For undirected graphs, From and To are interchangeable.
+//
+// V: Vertex's type.
+//
+// W: Weight's type. Use struct{} for unweighted graphs.
+type Edge[V comparable, W any] struct {
+	From   V
+	To     V
+	Weight W
+}
+
+// Graph a structure made of a set of vertices (V) connected by edges, each edge holding a weight (W).
+// Graphs may be directed, where edges go from one vertex to another, or undirected, where edges connect vertices
+// both ways. Unweighted graphs may use struct{} as weight type along with AddEdge.
+//
+// A graph cannot contain duplicate vertices nor parallel edges (i.e., two edges connecting the same vertices in the
+// same direction).
+type Graph[V comparable, W any] interface {
+	// IsDirected returns true if edges of this graph have a direction.
+	IsDirected() bool
+	// AddVertex adds a vertex into this graph. Returns false if the vertex was already present.
+	AddVertex(v V) bool
+	// RemoveVertex removes a vertex and all of its edges from this graph.
+	RemoveVertex(v V) bool
+	// ContainsVertex returns true if this graph contains the specified vertex.
+	ContainsVertex(v V) bool
+	// AddEdge adds an edge with a zero-value weight between the specified vertices, adding missing vertices.
+	// Returns false if the edge was already present.
+	AddEdge(from, to V) bool
+	// AddWeightedEdge adds an edge with the specified weight between the specified vertices, adding missing vertices.
+	// If the edge was already present, its weight is replaced and false is returned.
+	AddWeightedEdge(from, to V, weight W) bool
+	// RemoveEdge removes the edge between the specified vertices.
+	RemoveEdge(from, to V) bool
+	// ContainsEdge returns true if this graph contains an edge between the specified vertices.
+	ContainsEdge(from, to V) bool
+	// Weight returns the weight of the edge between the specified vertices.
+	Weight(from, to V) (W, bool)
+	// Vertices returns a collection.Collection view of the vertices of this graph.
+	Vertices() collection.Collection[V]
+	// Edges returns a collection.Collection view of the edges of this graph. Undirected edges are returned once.
+	Edges() collection.Collection[Edge[V, W]]
+	// Neighbors returns a collection.Collection view of the vertices reachable from v through a single edge.
+	Neighbors(v V) collection.Collection[V]
+	// InDegree returns the number of edges pointing to v. Equals OutDegree for undirected graphs.
+	InDegree(v V) int
+	// OutDegree returns the number of edges going out of v. Equals InDegree for undirected graphs.
+	OutDegree(v V) int
+	// Order returns the number of vertices in this graph.
+	Order() int
+	// Size returns the number of edges in this graph.
+	Size() int
+}
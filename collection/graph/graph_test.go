@@ -0,0 +1,98 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/graph"
+)
+
+// This file contains all tests for basic graph.Graph implementations. We do this merge
+// to keep nolan's Graph API homogeneous and thus, consistent, deterministic and predictable.
+
+var graphFactories = []struct {
+	name        string
+	factoryFunc func(directed bool) graph.Graph[string, int]
+}{
+	{
+		name: "adjacency_list",
+		factoryFunc: func(directed bool) graph.Graph[string, int] {
+			return graph.NewAdjacencyList[string, int](directed)
+		},
+	},
+	{
+		name: "adjacency_matrix",
+		factoryFunc: func(directed bool) graph.Graph[string, int] {
+			return graph.NewAdjacencyMatrix[string, int](directed)
+		},
+	},
+}
+
+func TestGraph_Directed(t *testing.T) {
+	for _, factory := range graphFactories {
+		t.Run(factory.name, func(t *testing.T) {
+			g := factory.factoryFunc(true)
+			assert.True(t, g.IsDirected())
+			assert.True(t, g.AddWeightedEdge("a", "b", 1))
+			assert.True(t, g.AddWeightedEdge("a", "c", 2))
+			assert.True(t, g.AddWeightedEdge("c", "b", 3))
+			assert.False(t, g.AddWeightedEdge("a", "b", 4))
+			assert.True(t, g.AddVertex("d"))
+			assert.False(t, g.AddVertex("d"))
+
+			assert.Equal(t, 4, g.Order())
+			assert.Equal(t, 3, g.Size())
+			assert.Equal(t, []string{"a", "b", "c", "d"}, g.Vertices().ToSlice())
+			assert.Equal(t, []string{"b", "c"}, g.Neighbors("a").ToSlice())
+			assert.Empty(t, g.Neighbors("b").ToSlice())
+			assert.Equal(t, 2, g.InDegree("b"))
+			assert.Equal(t, 0, g.OutDegree("b"))
+			assert.True(t, g.ContainsEdge("a", "b"))
+			assert.False(t, g.ContainsEdge("b", "a"))
+			weight, ok := g.Weight("a", "b")
+			assert.True(t, ok)
+			assert.Equal(t, 4, weight)
+			assert.Equal(t, []graph.Edge[string, int]{
+				{From: "a", To: "b", Weight: 4},
+				{From: "a", To: "c", Weight: 2},
+				{From: "c", To: "b", Weight: 3},
+			}, g.Edges().ToSlice())
+
+			assert.True(t, g.RemoveEdge("a", "b"))
+			assert.False(t, g.RemoveEdge("a", "b"))
+			assert.Equal(t, 1, g.InDegree("b"))
+			assert.True(t, g.RemoveVertex("c"))
+			assert.False(t, g.ContainsVertex("c"))
+			assert.Equal(t, 0, g.Size())
+			assert.Equal(t, 0, g.InDegree("b"))
+			assert.Equal(t, []string{"a", "b", "d"}, g.Vertices().ToSlice())
+		})
+	}
+}
+
+func TestGraph_Undirected(t *testing.T) {
+	for _, factory := range graphFactories {
+		t.Run(factory.name, func(t *testing.T) {
+			g := factory.factoryFunc(false)
+			assert.False(t, g.IsDirected())
+			g.AddEdge("a", "b")
+			g.AddEdge("b", "c")
+			assert.False(t, g.AddEdge("c", "b"))
+
+			assert.Equal(t, 2, g.Size())
+			assert.True(t, g.ContainsEdge("b", "a"))
+			assert.Equal(t, []string{"a", "c"}, g.Neighbors("b").ToSlice())
+			assert.Equal(t, 2, g.InDegree("b"))
+			assert.Equal(t, 2, g.OutDegree("b"))
+			assert.Equal(t, 2, g.Edges().Len())
+
+			assert.True(t, g.RemoveEdge("b", "a"))
+			assert.False(t, g.ContainsEdge("a", "b"))
+			assert.True(t, g.RemoveVertex("b"))
+			assert.Equal(t, 0, g.Size())
+			assert.Equal(t, 0, g.OutDegree("c"))
+			assert.Equal(t, 2, g.Order())
+		})
+	}
+}
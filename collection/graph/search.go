@@ -1,4 +1,336 @@
 package graph
 
-// TODO: IMPLEMENT ME
-//  - Add dijkstra algo
+import (
+	"github.com/neutrinocorp/nolan/collection/heap"
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/collection/maps"
+	"github.com/neutrinocorp/nolan/function"
+)
+
+// Number is a constraint that permits any numeric type, used to accumulate distances over a Graph.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// WeightFunc is a function.DelegateFunc type used to get the distance (D) covered when traversing an Edge.
+// It decouples shortest-path algorithms from the weight type (W) stored in a Graph.
+type WeightFunc[V comparable, W any, D Number] function.DelegateFunc[Edge[V, W], D]
+
+// HeuristicFunc is a function.DelegateFunc type used to estimate the distance (D) between a vertex and the target of
+// an A* search.
+type HeuristicFunc[V comparable, D Number] function.DelegateFunc[V, D]
+
+// EdgeWeight is a WeightFunc returning the weight stored in a numerically-weighted Graph.
+func EdgeWeight[V comparable, D Number](edge Edge[V, D]) D {
+	return edge.Weight
+}
+
+// UnitWeight is a WeightFunc returning 1 for every edge, making shortest paths the ones with the fewest edges
+// (e.g., for unweighted graphs).
+func UnitWeight[V comparable, W any, D Number](_ Edge[V, W]) D {
+	return 1
+}
+
+// forEachOutgoing traverses through all the edges going out of v.
+// Use predicate's return value to indicate a break of the iteration, TRUE meaning a break.
+func forEachOutgoing[V comparable, W any](g Graph[V, W], v V, predicateFunc func(edge Edge[V, W]) bool) {
+	g.Neighbors(v).ForEach(func(to V) bool {
+		weight, _ := g.Weight(v, to)
+		return predicateFunc(Edge[V, W]{From: v, To: to, Weight: weight})
+	})
+}
+
+// ShortestPaths holds the result of a single-source shortest-path algorithm.
+type ShortestPaths[V comparable, D Number] struct {
+	// Source is the vertex paths start from.
+	Source V
+	// Distances maps every reached vertex to its distance from Source.
+	Distances    maps.Map[V, D]
+	predecessors maps.HashMap[V, V]
+}
+
+func newShortestPaths[V comparable, D Number](source V) *ShortestPaths[V, D] {
+	return &ShortestPaths[V, D]{
+		Source:       source,
+		Distances:    maps.HashMap[V, D]{},
+		predecessors: maps.HashMap[V, V]{},
+	}
+}
+
+// DistanceTo returns the distance from Source to the specified vertex. Returns false if it was not reached.
+func (s *ShortestPaths[V, D]) DistanceTo(v V) (D, bool) {
+	return s.Distances.Get(v)
+}
+
+// PathTo returns the vertices of the shortest path from Source to target, both included.
+// Returns nil if target was not reached.
+func (s *ShortestPaths[V, D]) PathTo(target V) list.List[V] {
+	if !s.Distances.ContainsKey(target) {
+		return nil
+	}
+	return buildPath(s.Source, target, s.predecessors)
+}
+
+// buildPath walks predecessors back from target to source, returning the path in traversal order.
+func buildPath[V comparable](source, target V, predecessors map[V]V) list.List[V] {
+	buf := []V{target}
+	for current := target; current != source; {
+		current = predecessors[current]
+		buf = append(buf, current)
+	}
+	for i, j := 0, len(buf)-1; i < j; i, j = i+1, j-1 {
+		buf[i], buf[j] = buf[j], buf[i]
+	}
+	return list.NewSliceList(buf)
+}
+
+// Dijkstra computes the shortest paths from source to every reachable vertex of a graph whose weights are
+// non-negative, using a heap.FibonacciHeap as priority queue. Takes O(|E| + |V| log |V|) time.
+//
+// Returns ErrVertexNotFound if source is not part of the graph or ErrNegativeWeight if a negative weight is found.
+func Dijkstra[V comparable, W any, D Number](g Graph[V, W], source V,
+	weightFunc WeightFunc[V, W, D]) (*ShortestPaths[V, D], error) {
+	var noTarget V
+	return bestFirstSearch[V, W, D](g, source, noTarget, false, weightFunc, nil)
+}
+
+// AStar computes the shortest path from source to target, guided by a heuristic estimating the remaining distance
+// from a vertex to target. The heuristic must be admissible (i.e., never overestimate) for the path to be optimal;
+// if it is also consistent, every vertex is expanded at most once. The search stops as soon as target is reached,
+// thus the returned ShortestPaths only holds the vertices settled until then (use PathTo(target) to get the path).
+//
+// Returns ErrVertexNotFound if source is not part of the graph or ErrNegativeWeight if a negative weight is found.
+func AStar[V comparable, W any, D Number](g Graph[V, W], source, target V, weightFunc WeightFunc[V, W, D],
+	heuristicFunc HeuristicFunc[V, D]) (*ShortestPaths[V, D], error) {
+	return bestFirstSearch[V, W, D](g, source, target, true, weightFunc, heuristicFunc)
+}
+
+// bestFirstSearch is the routine shared by Dijkstra and AStar. Vertices are settled by ascending distance plus
+// heuristic estimate; if hasTarget is set, the search stops once target is settled.
+func bestFirstSearch[V comparable, W any, D Number](g Graph[V, W], source, target V, hasTarget bool,
+	weightFunc WeightFunc[V, W, D], heuristicFunc HeuristicFunc[V, D]) (*ShortestPaths[V, D], error) {
+	if !g.ContainsVertex(source) {
+		return nil, ErrVertexNotFound
+	} else if weightFunc == nil {
+		panic("weightFunc cannot be nil")
+	}
+	if heuristicFunc == nil {
+		heuristicFunc = func(_ V) D {
+			return 0
+		}
+	}
+
+	paths := newShortestPaths[V, D](source)
+	// costs holds the best known distance (g-score) of every discovered vertex
+	costs := map[V]D{source: 0}
+	openSet := heap.NewOrderedFibonacciHeap[D, V]()
+	handles := map[V]*heap.FibonacciHandle[D, V]{
+		source: openSet.Insert(heuristicFunc(source), source),
+	}
+	for !openSet.IsEmpty() {
+		handle, _ := openSet.ExtractMin()
+		current := handle.Value()
+		delete(handles, current)
+		paths.Distances.Put(current, costs[current])
+		if hasTarget && current == target {
+			break
+		}
+
+		var err error
+		forEachOutgoing(g, current, func(edge Edge[V, W]) bool {
+			weight := weightFunc(edge)
+			if weight < 0 {
+				err = ErrNegativeWeight
+				return true
+			}
+			cost := costs[current] + weight
+			if prevCost, ok := costs[edge.To]; ok && cost >= prevCost {
+				return false
+			}
+
+			costs[edge.To] = cost
+			paths.predecessors[edge.To] = current
+			priority := cost + heuristicFunc(edge.To)
+			if neighborHandle, ok := handles[edge.To]; ok {
+				_ = openSet.DecreaseKey(neighborHandle, priority)
+				return false
+			}
+			// vertex is either new or re-opened by an inconsistent heuristic
+			paths.Distances.Remove(edge.To)
+			handles[edge.To] = openSet.Insert(priority, edge.To)
+			return false
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return paths, nil
+}
+
+// BellmanFord computes the shortest paths from source to every reachable vertex, allowing negative weights.
+// Takes O(|V||E|) time.
+//
+// Returns ErrVertexNotFound if source is not part of the graph or ErrNegativeCycle if a negative cycle is reachable
+// from source.
+func BellmanFord[V comparable, W any, D Number](g Graph[V, W], source V,
+	weightFunc WeightFunc[V, W, D]) (*ShortestPaths[V, D], error) {
+	if !g.ContainsVertex(source) {
+		return nil, ErrVertexNotFound
+	} else if weightFunc == nil {
+		panic("weightFunc cannot be nil")
+	}
+
+	paths := newShortestPaths[V, D](source)
+	distances := maps.HashMap[V, D]{source: 0}
+	vertices := g.Vertices().ToSlice()
+	// relax returns true if any distance was shortened
+	relax := func() bool {
+		wasRelaxed := false
+		for _, vertex := range vertices {
+			distance, ok := distances[vertex]
+			if !ok {
+				continue
+			}
+			forEachOutgoing(g, vertex, func(edge Edge[V, W]) bool {
+				cost := distance + weightFunc(edge)
+				if prevCost, found := distances[edge.To]; !found || cost < prevCost {
+					distances[edge.To] = cost
+					paths.predecessors[edge.To] = vertex
+					wasRelaxed = true
+				}
+				return false
+			})
+		}
+		return wasRelaxed
+	}
+
+	for i := 0; i < len(vertices)-1; i++ {
+		if !relax() {
+			break
+		}
+	}
+	if relax() {
+		return nil, ErrNegativeCycle
+	}
+	paths.Distances = distances
+	return paths, nil
+}
+
+// AllPairsShortestPaths holds the result of an all-pairs shortest-path algorithm.
+type AllPairsShortestPaths[V comparable, D Number] struct {
+	indexes   map[V]int
+	vertices  []V
+	distances [][]D
+	// next holds the index of the vertex following i in the shortest path from i to j, or -1 if j is unreachable.
+	next [][]int
+}
+
+// Distance returns the distance of the shortest path from one vertex to another.
+// Returns false if there is no such path.
+func (a *AllPairsShortestPaths[V, D]) Distance(from, to V) (D, bool) {
+	fromIndex, okFrom := a.indexes[from]
+	toIndex, okTo := a.indexes[to]
+	if !okFrom || !okTo || a.next[fromIndex][toIndex] < 0 {
+		var zeroVal D
+		return zeroVal, false
+	}
+	return a.distances[fromIndex][toIndex], true
+}
+
+// DistancesFrom returns a map of every vertex reachable from the specified vertex along with its distance.
+func (a *AllPairsShortestPaths[V, D]) DistancesFrom(from V) maps.Map[V, D] {
+	buf := maps.HashMap[V, D]{}
+	fromIndex, ok := a.indexes[from]
+	if !ok {
+		return buf
+	}
+	for toIndex, next := range a.next[fromIndex] {
+		if next >= 0 {
+			buf[a.vertices[toIndex]] = a.distances[fromIndex][toIndex]
+		}
+	}
+	return buf
+}
+
+// Path returns the vertices of the shortest path from one vertex to another, both included.
+// Returns nil if there is no such path.
+func (a *AllPairsShortestPaths[V, D]) Path(from, to V) list.List[V] {
+	fromIndex, okFrom := a.indexes[from]
+	toIndex, okTo := a.indexes[to]
+	if !okFrom || !okTo || a.next[fromIndex][toIndex] < 0 {
+		return nil
+	}
+
+	buf := []V{from}
+	for current := fromIndex; current != toIndex; {
+		current = a.next[current][toIndex]
+		buf = append(buf, a.vertices[current])
+	}
+	return list.NewSliceList(buf)
+}
+
+// FloydWarshall computes the shortest paths between every pair of vertices, allowing negative weights.
+// Takes O(|V|^3) time and O(|V|^2) space.
+//
+// Returns ErrNegativeCycle if the graph contains a negative cycle.
+func FloydWarshall[V comparable, W any, D Number](g Graph[V, W],
+	weightFunc WeightFunc[V, W, D]) (*AllPairsShortestPaths[V, D], error) {
+	if weightFunc == nil {
+		panic("weightFunc cannot be nil")
+	}
+
+	vertices := g.Vertices().ToSlice()
+	n := len(vertices)
+	result := &AllPairsShortestPaths[V, D]{
+		indexes:   make(map[V]int, n),
+		vertices:  vertices,
+		distances: make([][]D, n),
+		next:      make([][]int, n),
+	}
+	for i, vertex := range vertices {
+		result.indexes[vertex] = i
+		result.distances[i] = make([]D, n)
+		result.next[i] = make([]int, n)
+		for j := range result.next[i] {
+			result.next[i][j] = -1
+		}
+		result.next[i][i] = i
+	}
+	for i, vertex := range vertices {
+		forEachOutgoing(g, vertex, func(edge Edge[V, W]) bool {
+			j := result.indexes[edge.To]
+			weight := weightFunc(edge)
+			if i != j || weight < 0 {
+				result.distances[i][j] = weight
+				result.next[i][j] = j
+			}
+			return false
+		})
+	}
+
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if result.next[i][k] < 0 {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if result.next[k][j] < 0 {
+					continue
+				}
+				cost := result.distances[i][k] + result.distances[k][j]
+				if result.next[i][j] < 0 || cost < result.distances[i][j] {
+					result.distances[i][j] = cost
+					result.next[i][j] = result.next[i][k]
+				}
+			}
+		}
+	}
+	for i := 0; i < n; i++ {
+		if result.distances[i][i] < 0 {
+			return nil, ErrNegativeCycle
+		}
+	}
+	return result, nil
+}
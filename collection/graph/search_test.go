@@ -0,0 +1,136 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutrinocorp/nolan/collection/graph"
+)
+
+func newRoadGraph(factoryFunc func(directed bool) graph.Graph[string, int]) graph.Graph[string, int] {
+	g := factoryFunc(true)
+	g.AddWeightedEdge("a", "b", 4)
+	g.AddWeightedEdge("a", "c", 1)
+	g.AddWeightedEdge("c", "b", 2)
+	g.AddWeightedEdge("b", "d", 1)
+	g.AddWeightedEdge("c", "d", 5)
+	g.AddWeightedEdge("d", "e", 3)
+	g.AddVertex("isolated")
+	return g
+}
+
+func TestShortestPaths_SingleSource(t *testing.T) {
+	algorithms := []struct {
+		name    string
+		runFunc func(g graph.Graph[string, int]) (*graph.ShortestPaths[string, int], error)
+	}{
+		{
+			name: "dijkstra",
+			runFunc: func(g graph.Graph[string, int]) (*graph.ShortestPaths[string, int], error) {
+				return graph.Dijkstra[string, int, int](g, "a", graph.EdgeWeight[string, int])
+			},
+		},
+		{
+			name: "bellman_ford",
+			runFunc: func(g graph.Graph[string, int]) (*graph.ShortestPaths[string, int], error) {
+				return graph.BellmanFord[string, int, int](g, "a", graph.EdgeWeight[string, int])
+			},
+		},
+	}
+
+	for _, factory := range graphFactories {
+		for _, algorithm := range algorithms {
+			t.Run(factory.name+" "+algorithm.name, func(t *testing.T) {
+				paths, err := algorithm.runFunc(newRoadGraph(factory.factoryFunc))
+				require.NoError(t, err)
+				distance, ok := paths.DistanceTo("e")
+				assert.True(t, ok)
+				assert.Equal(t, 7, distance)
+				assert.Equal(t, []string{"a", "c", "b", "d", "e"}, paths.PathTo("e").ToSlice())
+				assert.Equal(t, []string{"a"}, paths.PathTo("a").ToSlice())
+				assert.Nil(t, paths.PathTo("isolated"))
+				assert.Equal(t, 5, paths.Distances.Len())
+			})
+		}
+	}
+}
+
+func TestAStar(t *testing.T) {
+	// 4x4 grid, vertices are (row, col) cells; cell (1, 1) and (1, 2) are walls
+	type cell struct{ row, col int }
+	g := graph.NewAdjacencyList[cell, struct{}](false)
+	walls := map[cell]bool{{1, 1}: true, {1, 2}: true}
+	for row := 0; row < 4; row++ {
+		for col := 0; col < 4; col++ {
+			if walls[cell{row, col}] {
+				continue
+			}
+			if next := (cell{row, col + 1}); col < 3 && !walls[next] {
+				g.AddEdge(cell{row, col}, next)
+			}
+			if next := (cell{row + 1, col}); row < 3 && !walls[next] {
+				g.AddEdge(cell{row, col}, next)
+			}
+		}
+	}
+
+	target := cell{2, 2}
+	manhattan := func(c cell) int {
+		dRow, dCol := target.row-c.row, target.col-c.col
+		return max(dRow, -dRow) + max(dCol, -dCol)
+	}
+	paths, err := graph.AStar[cell, struct{}, int](g, cell{0, 1}, target,
+		graph.UnitWeight[cell, struct{}, int], manhattan)
+	require.NoError(t, err)
+	distance, ok := paths.DistanceTo(target)
+	assert.True(t, ok)
+	assert.Equal(t, 5, distance)
+	assert.Equal(t, 6, paths.PathTo(target).Len())
+
+	_, err = graph.AStar[cell, struct{}, int](g, cell{1, 1}, target, graph.UnitWeight[cell, struct{}, int], manhattan)
+	assert.ErrorIs(t, err, graph.ErrVertexNotFound)
+}
+
+func TestShortestPaths_NegativeWeights(t *testing.T) {
+	g := graph.NewAdjacencyList[string, int](true)
+	g.AddWeightedEdge("a", "b", 4)
+	g.AddWeightedEdge("a", "c", 2)
+	g.AddWeightedEdge("b", "c", -3)
+
+	_, err := graph.Dijkstra[string, int, int](g, "a", graph.EdgeWeight[string, int])
+	assert.ErrorIs(t, err, graph.ErrNegativeWeight)
+
+	paths, err := graph.BellmanFord[string, int, int](g, "a", graph.EdgeWeight[string, int])
+	require.NoError(t, err)
+	distance, _ := paths.DistanceTo("c")
+	assert.Equal(t, 1, distance)
+
+	g.AddWeightedEdge("c", "a", -2) // a -> b -> c -> a costs -1
+	_, err = graph.BellmanFord[string, int, int](g, "a", graph.EdgeWeight[string, int])
+	assert.ErrorIs(t, err, graph.ErrNegativeCycle)
+	_, err = graph.FloydWarshall[string, int, int](g, graph.EdgeWeight[string, int])
+	assert.ErrorIs(t, err, graph.ErrNegativeCycle)
+}
+
+func TestFloydWarshall(t *testing.T) {
+	for _, factory := range graphFactories {
+		t.Run(factory.name, func(t *testing.T) {
+			paths, err := graph.FloydWarshall[string, int, int](newRoadGraph(factory.factoryFunc),
+				graph.EdgeWeight[string, int])
+			require.NoError(t, err)
+
+			distance, ok := paths.Distance("a", "e")
+			assert.True(t, ok)
+			assert.Equal(t, 7, distance)
+			distance, _ = paths.Distance("c", "e")
+			assert.Equal(t, 6, distance)
+			_, ok = paths.Distance("e", "a")
+			assert.False(t, ok)
+			assert.Equal(t, []string{"c", "b", "d", "e"}, paths.Path("c", "e").ToSlice())
+			assert.Nil(t, paths.Path("isolated", "a"))
+			assert.Equal(t, 5, paths.DistancesFrom("a").Len())
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"sort"
+
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/heap"
+	"github.com/neutrinocorp/nolan/collection/set"
+)
+
+// SpanningTree holds the result of a minimum spanning tree algorithm. If the graph is not connected, this is a
+// minimum spanning forest: one tree per connected component.
+type SpanningTree[V comparable, W any, D Number] struct {
+	// Edges holds the edges of the tree, in the order they were selected.
+	Edges []Edge[V, W]
+	// Weight is the sum of the weights of Edges.
+	Weight D
+}
+
+// Kruskal computes a minimum spanning tree of an undirected graph by selecting edges by ascending weight, skipping
+// the ones connecting already-connected vertices (tracked with a set.DisjointSet). Takes O(|E| log |E|) time.
+//
+// Returns ErrDirectedGraph if the graph is directed.
+func Kruskal[V comparable, W any, D Number](g Graph[V, W],
+	weightFunc WeightFunc[V, W, D]) (*SpanningTree[V, W, D], error) {
+	if g.IsDirected() {
+		return nil, ErrDirectedGraph
+	} else if weightFunc == nil {
+		panic("weightFunc cannot be nil")
+	}
+
+	edges := g.Edges().ToSlice()
+	weights := make([]D, len(edges))
+	for i, edge := range edges {
+		weights[i] = weightFunc(edge)
+	}
+	order := make([]int, len(edges))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return weights[order[i]] < weights[order[j]]
+	})
+
+	tree := &SpanningTree[V, W, D]{}
+	components := set.NewDisjointSet[V](g.Vertices().ToSlice()...)
+	for _, index := range order {
+		if components.SetCount() == 1 {
+			break
+		}
+		if components.Union(edges[index].From, edges[index].To) {
+			tree.Edges = append(tree.Edges, edges[index])
+			tree.Weight += weights[index]
+		}
+	}
+	return tree, nil
+}
+
+// primCandidate is a structure used to hold an edge crossing the frontier of the tree grown by Prim.
+type primCandidate[V comparable, W any, D Number] struct {
+	edge   Edge[V, W]
+	weight D
+}
+
+// Prim computes a minimum spanning tree of an undirected graph by growing it from a vertex, always selecting the
+// lightest edge leaving the tree (tracked with a heap.BinaryHeap). Trees are grown from vertices in the order the graph
+// yields them. Takes O(|E| log |E|) time.
+//
+// Returns ErrDirectedGraph if the graph is directed.
+func Prim[V comparable, W any, D Number](g Graph[V, W],
+	weightFunc WeightFunc[V, W, D]) (*SpanningTree[V, W, D], error) {
+	if g.IsDirected() {
+		return nil, ErrDirectedGraph
+	} else if weightFunc == nil {
+		panic("weightFunc cannot be nil")
+	}
+
+	tree := &SpanningTree[V, W, D]{}
+	inTree := make(map[V]struct{}, g.Order())
+	candidates := heap.NewBinaryHeap[primCandidate[V, W, D]](func(a, b primCandidate[V, W, D]) int {
+		return collection.Compare(a.weight, b.weight)
+	})
+	grow := func(v V) {
+		inTree[v] = struct{}{}
+		forEachOutgoing(g, v, func(edge Edge[V, W]) bool {
+			if _, ok := inTree[edge.To]; !ok {
+				candidates.Push(primCandidate[V, W, D]{edge: edge, weight: weightFunc(edge)})
+			}
+			return false
+		})
+	}
+	g.Vertices().ForEach(func(root V) bool {
+		if _, ok := inTree[root]; ok {
+			return false
+		}
+		grow(root)
+		for !candidates.IsEmpty() {
+			candidate, _ := candidates.Pop()
+			if _, ok := inTree[candidate.edge.To]; ok {
+				continue
+			}
+			tree.Edges = append(tree.Edges, candidate.edge)
+			tree.Weight += candidate.weight
+			grow(candidate.edge.To)
+		}
+		return false
+	})
+	return tree, nil
+}
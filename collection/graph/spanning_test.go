@@ -0,0 +1,67 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutrinocorp/nolan/collection/graph"
+)
+
+func TestMinimumSpanningTree(t *testing.T) {
+	algorithms := []struct {
+		name     string
+		runFunc  func(g graph.Graph[string, int]) (*graph.SpanningTree[string, int, int], error)
+		expEdges []graph.Edge[string, int]
+	}{
+		{
+			name: "kruskal",
+			runFunc: func(g graph.Graph[string, int]) (*graph.SpanningTree[string, int, int], error) {
+				return graph.Kruskal[string, int, int](g, graph.EdgeWeight[string, int])
+			},
+			expEdges: []graph.Edge[string, int]{
+				{From: "a", To: "b", Weight: 1},
+				{From: "x", To: "y", Weight: 1},
+				{From: "b", To: "c", Weight: 2},
+				{From: "c", To: "d", Weight: 3},
+			},
+		},
+		{
+			name: "prim",
+			runFunc: func(g graph.Graph[string, int]) (*graph.SpanningTree[string, int, int], error) {
+				return graph.Prim[string, int, int](g, graph.EdgeWeight[string, int])
+			},
+			expEdges: []graph.Edge[string, int]{
+				{From: "a", To: "b", Weight: 1},
+				{From: "b", To: "c", Weight: 2},
+				{From: "c", To: "d", Weight: 3},
+				{From: "x", To: "y", Weight: 1},
+			},
+		},
+	}
+
+	for _, factory := range graphFactories {
+		for _, algorithm := range algorithms {
+			t.Run(factory.name+" "+algorithm.name, func(t *testing.T) {
+				g := factory.factoryFunc(false)
+				g.AddWeightedEdge("a", "b", 1)
+				g.AddWeightedEdge("a", "c", 4)
+				g.AddWeightedEdge("b", "c", 2)
+				g.AddWeightedEdge("b", "d", 5)
+				g.AddWeightedEdge("c", "d", 3)
+				g.AddWeightedEdge("a", "d", 7)
+				// second component, making the result a forest
+				g.AddWeightedEdge("x", "y", 1)
+
+				tree, err := algorithm.runFunc(g)
+				require.NoError(t, err)
+				assert.Equal(t, algorithm.expEdges, tree.Edges)
+				assert.Equal(t, 7, tree.Weight)
+
+				_, err = algorithm.runFunc(factory.factoryFunc(true))
+				assert.ErrorIs(t, err, graph.ErrDirectedGraph)
+			})
+		}
+	}
+}
@@ -0,0 +1,293 @@
+package graph
+
+import (
+	"fmt"
+
+	"github.com/neutrinocorp/nolan/collection/list"
+)
+
+// CycleError is the error returned by TopologicalSort when the graph is not acyclic. It wraps ErrCycle.
+type CycleError[V comparable] struct {
+	// Cycle holds the vertices of one of the offending cycles, in edge order. The first vertex is repeated at the
+	// end to close the cycle (e.g., [a b c a] for a -> b -> c -> a).
+	Cycle []V
+}
+
+var _ error = &CycleError[string]{}
+
+func (e *CycleError[V]) Error() string {
+	return fmt.Sprintf("%s: %v", ErrCycle.Error(), e.Cycle)
+}
+
+// Unwrap returns ErrCycle, making errors.Is(err, ErrCycle) hold.
+func (e *CycleError[V]) Unwrap() error {
+	return ErrCycle
+}
+
+// dfsFrame is a structure used to hold the state of a vertex being explored by iterative depth-first searches.
+type dfsFrame[V comparable] struct {
+	vertex    V
+	parent    V
+	neighbors []V
+	next      int
+}
+
+func newDFSFrame[V comparable, W any](g Graph[V, W], v, parent V) dfsFrame[V] {
+	return dfsFrame[V]{
+		vertex:    v,
+		parent:    parent,
+		neighbors: g.Neighbors(v).ToSlice(),
+	}
+}
+
+// TopologicalSort returns the vertices of a directed acyclic graph ordered so every edge goes from an earlier vertex
+// to a later one (e.g., a build order where every dependency comes before its dependents), using Kahn's algorithm.
+// Ties are broken by the order the graph yields its vertices. Takes O(|V| + |E|) time.
+//
+// Returns a *CycleError holding one of the offending cycles if the graph is not acyclic or ErrUndirectedGraph if the
+// graph is undirected.
+func TopologicalSort[V comparable, W any](g Graph[V, W]) (list.List[V], error) {
+	if !g.IsDirected() {
+		return nil, ErrUndirectedGraph
+	}
+
+	vertices := g.Vertices().ToSlice()
+	inDegrees := make(map[V]int, len(vertices))
+	queue := make([]V, 0, len(vertices))
+	for _, vertex := range vertices {
+		inDegrees[vertex] = g.InDegree(vertex)
+		if inDegrees[vertex] == 0 {
+			queue = append(queue, vertex)
+		}
+	}
+
+	buf := make([]V, 0, len(vertices))
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		buf = append(buf, current)
+		delete(inDegrees, current)
+		g.Neighbors(current).ForEach(func(neighbor V) bool {
+			inDegrees[neighbor]--
+			if inDegrees[neighbor] == 0 {
+				queue = append(queue, neighbor)
+			}
+			return false
+		})
+	}
+	if len(inDegrees) > 0 {
+		// every vertex left has an unresolved predecessor, thus the remaining subgraph holds at least one cycle
+		return nil, &CycleError[V]{Cycle: findCycle(g, vertices, inDegrees)}
+	}
+	return list.NewSliceList(buf), nil
+}
+
+// findCycle returns a cycle within the subgraph induced by the vertices in remaining, using a depth-first search
+// looking for an edge pointing back to a vertex of the current path.
+func findCycle[V comparable, W any](g Graph[V, W], vertices []V, remaining map[V]int) []V {
+	const (
+		unvisited = iota
+		inPath
+		done
+	)
+	states := make(map[V]int, len(remaining))
+	for _, root := range vertices {
+		if _, ok := remaining[root]; !ok || states[root] != unvisited {
+			continue
+		}
+
+		states[root] = inPath
+		frames := []dfsFrame[V]{newDFSFrame(g, root, root)}
+		for len(frames) > 0 {
+			frame := &frames[len(frames)-1]
+			if frame.next == len(frame.neighbors) {
+				states[frame.vertex] = done
+				frames = frames[:len(frames)-1]
+				continue
+			}
+
+			neighbor := frame.neighbors[frame.next]
+			frame.next++
+			if _, ok := remaining[neighbor]; !ok {
+				continue
+			}
+			switch states[neighbor] {
+			case unvisited:
+				states[neighbor] = inPath
+				frames = append(frames, newDFSFrame(g, neighbor, frame.vertex))
+			case inPath:
+				start := len(frames) - 1
+				for frames[start].vertex != neighbor {
+					start--
+				}
+				cycle := make([]V, 0, len(frames)-start+1)
+				for _, pathFrame := range frames[start:] {
+					cycle = append(cycle, pathFrame.vertex)
+				}
+				return append(cycle, neighbor)
+			}
+		}
+	}
+	return nil
+}
+
+// StronglyConnectedComponents returns the strongly connected components of a graph (i.e., the maximal sets of
+// vertices where every vertex is reachable from every other one), using Tarjan's algorithm. Components are returned
+// in reverse topological order of the condensed graph: no component has an edge to a later one.
+// For undirected graphs, these are the connected components. Takes O(|V| + |E|) time.
+func StronglyConnectedComponents[V comparable, W any](g Graph[V, W]) [][]V {
+	vertices := g.Vertices().ToSlice()
+	indexes := make(map[V]int, len(vertices))
+	lowLinks := make(map[V]int, len(vertices))
+	onStack := make(map[V]struct{}, len(vertices))
+	stack := make([]V, 0, len(vertices))
+	var components [][]V
+
+	visit := func(v V) {
+		indexes[v] = len(indexes)
+		lowLinks[v] = indexes[v]
+		onStack[v] = struct{}{}
+		stack = append(stack, v)
+	}
+	for _, root := range vertices {
+		if _, ok := indexes[root]; ok {
+			continue
+		}
+
+		visit(root)
+		frames := []dfsFrame[V]{newDFSFrame(g, root, root)}
+		for len(frames) > 0 {
+			frame := &frames[len(frames)-1]
+			if frame.next < len(frame.neighbors) {
+				neighbor := frame.neighbors[frame.next]
+				frame.next++
+				if _, ok := indexes[neighbor]; !ok {
+					visit(neighbor)
+					frames = append(frames, newDFSFrame(g, neighbor, frame.vertex))
+				} else if _, ok = onStack[neighbor]; ok {
+					lowLinks[frame.vertex] = min(lowLinks[frame.vertex], indexes[neighbor])
+				}
+				continue
+			}
+
+			current := frame.vertex
+			frames = frames[:len(frames)-1]
+			if len(frames) > 0 {
+				parent := frames[len(frames)-1].vertex
+				lowLinks[parent] = min(lowLinks[parent], lowLinks[current])
+			}
+			if lowLinks[current] != indexes[current] {
+				continue
+			}
+			// current is the root of a component; pop it from the stack
+			var component []V
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				delete(onStack, top)
+				component = append(component, top)
+				if top == current {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+	return components
+}
+
+// ArticulationPoints returns the articulation points (i.e., cut vertices) of an undirected graph: the vertices whose
+// removal increases the number of connected components. Vertices are returned in the order the graph yields them.
+// Takes O(|V| + |E|) time.
+//
+// Returns ErrDirectedGraph if the graph is directed.
+func ArticulationPoints[V comparable, W any](g Graph[V, W]) ([]V, error) {
+	if g.IsDirected() {
+		return nil, ErrDirectedGraph
+	}
+
+	isCut := make(map[V]struct{})
+	walkLowLinks(g, func(parent, child V, parentIndex, childLowLink int, isRoot bool, rootChildren int) {
+		if (isRoot && rootChildren > 1) || (!isRoot && childLowLink >= parentIndex) {
+			isCut[parent] = struct{}{}
+		}
+	})
+	buf := make([]V, 0, len(isCut))
+	g.Vertices().ForEach(func(v V) bool {
+		if _, ok := isCut[v]; ok {
+			buf = append(buf, v)
+		}
+		return false
+	})
+	return buf, nil
+}
+
+// Bridges returns the bridges (i.e., cut edges) of an undirected graph: the edges whose removal increases the number
+// of connected components. Edges are returned in depth-first post-order. Takes O(|V| + |E|) time.
+//
+// Returns ErrDirectedGraph if the graph is directed.
+func Bridges[V comparable, W any](g Graph[V, W]) ([]Edge[V, W], error) {
+	if g.IsDirected() {
+		return nil, ErrDirectedGraph
+	}
+
+	var buf []Edge[V, W]
+	walkLowLinks(g, func(parent, child V, parentIndex, childLowLink int, _ bool, _ int) {
+		if childLowLink > parentIndex {
+			weight, _ := g.Weight(parent, child)
+			buf = append(buf, Edge[V, W]{From: parent, To: child, Weight: weight})
+		}
+	})
+	return buf, nil
+}
+
+// walkLowLinks runs a depth-first search over an undirected graph computing discovery indexes and low-links
+// (i.e., the lowest index reachable from a subtree through a single back edge). Once the subtree of child has been
+// explored, treeEdgeFunc is called with the tree edge parent-child, whether parent is the root of the search tree and,
+// if so, the number of children explored from it so far.
+func walkLowLinks[V comparable, W any](g Graph[V, W],
+	treeEdgeFunc func(parent, child V, parentIndex, childLowLink int, isRoot bool, rootChildren int)) {
+	vertices := g.Vertices().ToSlice()
+	indexes := make(map[V]int, len(vertices))
+	lowLinks := make(map[V]int, len(vertices))
+	visit := func(v V) {
+		indexes[v] = len(indexes)
+		lowLinks[v] = indexes[v]
+	}
+	for _, root := range vertices {
+		if _, ok := indexes[root]; ok {
+			continue
+		}
+
+		visit(root)
+		rootChildren := 0
+		frames := []dfsFrame[V]{newDFSFrame(g, root, root)}
+		for len(frames) > 0 {
+			frame := &frames[len(frames)-1]
+			if frame.next < len(frame.neighbors) {
+				neighbor := frame.neighbors[frame.next]
+				frame.next++
+				if _, ok := indexes[neighbor]; !ok {
+					visit(neighbor)
+					frames = append(frames, newDFSFrame(g, neighbor, frame.vertex))
+				} else if neighbor != frame.parent {
+					lowLinks[frame.vertex] = min(lowLinks[frame.vertex], indexes[neighbor])
+				}
+				continue
+			}
+
+			current := frame.vertex
+			frames = frames[:len(frames)-1]
+			if len(frames) == 0 {
+				continue
+			}
+			parent := frames[len(frames)-1].vertex
+			lowLinks[parent] = min(lowLinks[parent], lowLinks[current])
+			isRoot := len(frames) == 1
+			if isRoot {
+				rootChildren++
+			}
+			treeEdgeFunc(parent, current, indexes[parent], lowLinks[current], isRoot, rootChildren)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package graph_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutrinocorp/nolan/collection/graph"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	for _, factory := range graphFactories {
+		t.Run(factory.name, func(t *testing.T) {
+			g := factory.factoryFunc(true)
+			g.AddEdge("compile", "test")
+			g.AddEdge("fetch", "compile")
+			g.AddEdge("compile", "package")
+			g.AddEdge("test", "package")
+			g.AddEdge("lint", "package")
+
+			order, err := graph.TopologicalSort[string, int](g)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"fetch", "lint", "compile", "test", "package"}, order.ToSlice())
+
+			g.AddEdge("package", "fetch")
+			_, err = graph.TopologicalSort[string, int](g)
+			assert.ErrorIs(t, err, graph.ErrCycle)
+			var cycleErr *graph.CycleError[string]
+			require.True(t, errors.As(err, &cycleErr))
+			assert.Equal(t, []string{"compile", "test", "package", "fetch", "compile"}, cycleErr.Cycle)
+
+			g.RemoveEdge("package", "fetch")
+			g.AddEdge("lint", "lint")
+			_, err = graph.TopologicalSort[string, int](g)
+			require.True(t, errors.As(err, &cycleErr))
+			assert.Equal(t, []string{"lint", "lint"}, cycleErr.Cycle)
+
+			_, err = graph.TopologicalSort[string, int](factory.factoryFunc(false))
+			assert.ErrorIs(t, err, graph.ErrUndirectedGraph)
+		})
+	}
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	for _, factory := range graphFactories {
+		t.Run(factory.name, func(t *testing.T) {
+			g := factory.factoryFunc(true)
+			g.AddEdge("a", "b")
+			g.AddEdge("b", "c")
+			g.AddEdge("c", "a")
+			g.AddEdge("c", "d")
+			g.AddEdge("d", "e")
+			g.AddEdge("e", "d")
+			g.AddVertex("f")
+			assert.Equal(t, [][]string{{"e", "d"}, {"c", "b", "a"}, {"f"}}, graph.StronglyConnectedComponents(g))
+
+			undirected := factory.factoryFunc(false)
+			undirected.AddEdge("a", "b")
+			undirected.AddEdge("c", "d")
+			assert.Equal(t, [][]string{{"b", "a"}, {"d", "c"}}, graph.StronglyConnectedComponents(undirected))
+		})
+	}
+}
+
+func TestArticulationPointsAndBridges(t *testing.T) {
+	for _, factory := range graphFactories {
+		t.Run(factory.name, func(t *testing.T) {
+			g := factory.factoryFunc(false)
+			// triangle a-b-c hanging from d, which connects to the e-f path
+			g.AddWeightedEdge("a", "b", 1)
+			g.AddWeightedEdge("b", "c", 2)
+			g.AddWeightedEdge("c", "a", 3)
+			g.AddWeightedEdge("c", "d", 4)
+			g.AddWeightedEdge("d", "e", 5)
+			g.AddWeightedEdge("e", "f", 6)
+			g.AddVertex("g")
+
+			points, err := graph.ArticulationPoints[string, int](g)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"c", "d", "e"}, points)
+
+			bridges, err := graph.Bridges[string, int](g)
+			require.NoError(t, err)
+			assert.Equal(t, []graph.Edge[string, int]{
+				{From: "e", To: "f", Weight: 6},
+				{From: "d", To: "e", Weight: 5},
+				{From: "c", To: "d", Weight: 4},
+			}, bridges)
+
+			_, err = graph.ArticulationPoints[string, int](factory.factoryFunc(true))
+			assert.ErrorIs(t, err, graph.ErrDirectedGraph)
+			_, err = graph.Bridges[string, int](factory.factoryFunc(true))
+			assert.ErrorIs(t, err, graph.ErrDirectedGraph)
+		})
+	}
+}
+
+func TestArticulationPoints_Root(t *testing.T) {
+	g := graph.NewAdjacencyList[int, struct{}](false)
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 3)
+	points, err := graph.ArticulationPoints[int, struct{}](g)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, points)
+}
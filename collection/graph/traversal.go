@@ -0,0 +1,149 @@
+package graph
+
+import (
+	"github.com/neutrinocorp/nolan/collection"
+)
+
+// traversalIterator is the implementation of collection.Iterator lazily traversing the vertices reachable from a
+// source vertex, either breadth-first (frontier used as a queue) or depth-first (frontier used as a stack).
+//
+// Vertices are discovered as Next is called. Previous walks the traversal backwards starting from its last vertex,
+// thus the first call to HasPrevious completes the traversal.
+type traversalIterator[V comparable, W any] struct {
+	graph      Graph[V, W]
+	depthFirst bool
+	frontier   []V
+	visited    map[V]struct{}
+	// order holds the vertices discovered so far, in traversal order.
+	order                []V
+	currentForwardIndex  int
+	currentBackwardIndex int
+	isBackwardInit       bool
+}
+
+var _ collection.Iterator[string] = &traversalIterator[string, int]{}
+
+// NewBreadthFirstIterator returns an iterator over the vertices reachable from source in breadth-first order
+// (i.e., by ascending number of edges from source). Neighbors are visited in the order the graph yields them.
+// The iterator is empty if source is not part of the graph.
+func NewBreadthFirstIterator[V comparable, W any](g Graph[V, W], source V) collection.Iterator[V] {
+	return newTraversalIterator(g, source, false)
+}
+
+// NewDepthFirstIterator returns an iterator over the vertices reachable from source in depth-first pre-order
+// (i.e., a vertex is yielded before its descendants). Neighbors are visited in the order the graph yields them.
+// The iterator is empty if source is not part of the graph.
+func NewDepthFirstIterator[V comparable, W any](g Graph[V, W], source V) collection.Iterator[V] {
+	return newTraversalIterator(g, source, true)
+}
+
+func newTraversalIterator[V comparable, W any](g Graph[V, W], source V, depthFirst bool) *traversalIterator[V, W] {
+	iter := &traversalIterator[V, W]{
+		graph:      g,
+		depthFirst: depthFirst,
+		visited:    make(map[V]struct{}),
+	}
+	if g.ContainsVertex(source) {
+		iter.frontier = append(iter.frontier, source)
+		if !depthFirst {
+			iter.visited[source] = struct{}{}
+		}
+	}
+	return iter
+}
+
+// advance discovers the next vertex of the traversal. Returns false if the traversal is complete.
+func (i *traversalIterator[V, W]) advance() bool {
+	if i.depthFirst {
+		return i.advanceDepthFirst()
+	}
+	if len(i.frontier) == 0 {
+		return false
+	}
+
+	current := i.frontier[0]
+	var zeroVal V
+	i.frontier[0] = zeroVal
+	i.frontier = i.frontier[1:]
+	i.order = append(i.order, current)
+	i.graph.Neighbors(current).ForEach(func(neighbor V) bool {
+		if _, ok := i.visited[neighbor]; !ok {
+			i.visited[neighbor] = struct{}{}
+			i.frontier = append(i.frontier, neighbor)
+		}
+		return false
+	})
+	return true
+}
+
+func (i *traversalIterator[V, W]) advanceDepthFirst() bool {
+	for len(i.frontier) > 0 {
+		current := i.frontier[len(i.frontier)-1]
+		i.frontier = i.frontier[:len(i.frontier)-1]
+		if _, ok := i.visited[current]; ok {
+			continue
+		}
+
+		i.visited[current] = struct{}{}
+		i.order = append(i.order, current)
+		// neighbors are pushed in reverse order so the first one is visited first
+		neighbors := i.graph.Neighbors(current).ToSlice()
+		for j := len(neighbors) - 1; j >= 0; j-- {
+			if _, ok := i.visited[neighbors[j]]; !ok {
+				i.frontier = append(i.frontier, neighbors[j])
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (i *traversalIterator[V, W]) initBackward() {
+	if i.isBackwardInit {
+		return
+	}
+	for i.advance() {
+	}
+	i.currentBackwardIndex = len(i.order) - 1
+	i.isBackwardInit = true
+}
+
+// HasNext indicates if the iterator has another item to retrieve.
+func (i *traversalIterator[V, W]) HasNext() bool {
+	return i.currentForwardIndex < len(i.order) || i.advance()
+}
+
+// Next retrieves the next item.
+func (i *traversalIterator[V, W]) Next() V {
+	if !i.HasNext() {
+		var zeroVal V
+		return zeroVal
+	}
+	v := i.order[i.currentForwardIndex]
+	i.currentForwardIndex++
+	return v
+}
+
+// HasPrevious indicates if the iterator has another item to retrieve.
+func (i *traversalIterator[V, W]) HasPrevious() bool {
+	i.initBackward()
+	return i.currentBackwardIndex >= 0
+}
+
+// Previous retrieves the previous item.
+func (i *traversalIterator[V, W]) Previous() V {
+	if !i.HasPrevious() {
+		var zeroVal V
+		return zeroVal
+	}
+	v := i.order[i.currentBackwardIndex]
+	i.currentBackwardIndex--
+	return v
+}
+
+// Reset restarts the state of the Iterator to default values. Vertices already discovered are replayed instead of
+// being traversed again.
+func (i *traversalIterator[V, W]) Reset() {
+	i.currentForwardIndex = 0
+	i.currentBackwardIndex = len(i.order) - 1
+}
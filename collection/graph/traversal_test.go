@@ -0,0 +1,93 @@
+package graph_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/graph"
+)
+
+func drainIterator[T any](iter collection.Iterator[T]) []T {
+	var buf []T
+	for iter.HasNext() {
+		buf = append(buf, iter.Next())
+	}
+	return buf
+}
+
+func TestTraversalIterators(t *testing.T) {
+	tests := []struct {
+		name      string
+		iterFunc  func(g graph.Graph[string, int], source string) collection.Iterator[string]
+		source    string
+		exp       []string
+		expLength int
+	}{
+		{
+			name:     "breadth first",
+			iterFunc: graph.NewBreadthFirstIterator[string, int],
+			source:   "a",
+			exp:      []string{"a", "b", "c", "d", "e", "f"},
+		},
+		{
+			name:     "depth first",
+			iterFunc: graph.NewDepthFirstIterator[string, int],
+			source:   "a",
+			exp:      []string{"a", "b", "d", "f", "c", "e"},
+		},
+		{
+			name:     "breadth first from leaf",
+			iterFunc: graph.NewBreadthFirstIterator[string, int],
+			source:   "f",
+			exp:      []string{"f"},
+		},
+		{
+			name:     "depth first missing source",
+			iterFunc: graph.NewDepthFirstIterator[string, int],
+			source:   "z",
+			exp:      nil,
+		},
+	}
+
+	for _, factory := range graphFactories {
+		for _, tt := range tests {
+			t.Run(factory.name+" "+tt.name, func(t *testing.T) {
+				g := factory.factoryFunc(true)
+				g.AddEdge("a", "b")
+				g.AddEdge("a", "c")
+				g.AddEdge("b", "d")
+				g.AddEdge("c", "e")
+				g.AddEdge("d", "f")
+				g.AddEdge("e", "a")
+				g.AddVertex("unreachable")
+
+				iter := tt.iterFunc(g, tt.source)
+				assert.Equal(t, tt.exp, drainIterator(iter))
+				assert.False(t, iter.HasNext())
+
+				var reversed []string
+				for iter.HasPrevious() {
+					reversed = append([]string{iter.Previous()}, reversed...)
+				}
+				assert.Equal(t, tt.exp, reversed)
+
+				iter.Reset()
+				assert.Equal(t, tt.exp, drainIterator(iter))
+			})
+		}
+	}
+}
+
+func TestTraversalIterators_BackwardFirst(t *testing.T) {
+	g := graph.NewAdjacencyList[int, struct{}](false)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(1, 4)
+
+	iter := graph.NewBreadthFirstIterator[int, struct{}](g, 1)
+	assert.True(t, iter.HasPrevious())
+	assert.Equal(t, 3, iter.Previous())
+	assert.Equal(t, []int{1, 2, 4, 3}, drainIterator(iter))
+}
@@ -1,3 +1,191 @@
 package heap
 
-// TODO: IMPLEMENT ME
+import (
+	"github.com/neutrinocorp/nolan/collection"
+)
+
+// BinaryHeap is the slice-backed binary heap implementation of a priority queue. The element comparing as the
+// smallest one by the heap's collection.ComparatorFunc is always at the top (i.e., a min-heap); use a reversed
+// comparator to build a max-heap.
+//
+// Push and Pop take O(log n) time while Peek takes O(1) time. Heaps built from an existing slice or collection are
+// heapified in O(n) time.
+type BinaryHeap[T any] struct {
+	items      []T
+	comparator collection.ComparatorFunc[T]
+}
+
+// NewBinaryHeap allocates a new BinaryHeap instance ordering elements with the given comparator.
+func NewBinaryHeap[T any](comparator collection.ComparatorFunc[T]) *BinaryHeap[T] {
+	if comparator == nil {
+		panic("comparator cannot be nil")
+	}
+	return &BinaryHeap[T]{
+		comparator: comparator,
+	}
+}
+
+// NewOrderedBinaryHeap allocates a new BinaryHeap instance ordering elements by their natural order
+// (see collection.Compare).
+func NewOrderedBinaryHeap[T collection.Ordered]() *BinaryHeap[T] {
+	return NewBinaryHeap[T](collection.Compare[T])
+}
+
+// NewBinaryHeapFromSlice allocates a new BinaryHeap instance copying 'src' items into it.
+// Takes O(n) time.
+func NewBinaryHeapFromSlice[T any](comparator collection.ComparatorFunc[T], src []T) *BinaryHeap[T] {
+	h := NewBinaryHeap[T](comparator)
+	h.items = make([]T, len(src))
+	copy(h.items, src)
+	h.heapify()
+	return h
+}
+
+// NewBinaryHeapFromCollection allocates a new BinaryHeap instance copying 'src' items into it.
+// Takes O(n) time.
+func NewBinaryHeapFromCollection[T any](comparator collection.ComparatorFunc[T],
+	src collection.Collection[T]) *BinaryHeap[T] {
+	h := NewBinaryHeap[T](comparator)
+	h.items = make([]T, 0, src.Len())
+	src.ForEach(func(a T) bool {
+		h.items = append(h.items, a)
+		return false
+	})
+	h.heapify()
+	return h
+}
+
+func (h *BinaryHeap[T]) less(i, j int) bool {
+	return h.comparator(h.items[i], h.items[j]) < 0
+}
+
+func (h *BinaryHeap[T]) heapify() {
+	for i := len(h.items)/2 - 1; i >= 0; i-- {
+		h.siftDown(i)
+	}
+}
+
+func (h *BinaryHeap[T]) siftUp(index int) {
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !h.less(index, parent) {
+			break
+		}
+		h.items[index], h.items[parent] = h.items[parent], h.items[index]
+		index = parent
+	}
+}
+
+func (h *BinaryHeap[T]) siftDown(index int) {
+	n := len(h.items)
+	for {
+		smallest := index
+		left, right := 2*index+1, 2*index+2
+		if left < n && h.less(left, smallest) {
+			smallest = left
+		}
+		if right < n && h.less(right, smallest) {
+			smallest = right
+		}
+		if smallest == index {
+			return
+		}
+		h.items[index], h.items[smallest] = h.items[smallest], h.items[index]
+		index = smallest
+	}
+}
+
+// Push inserts the specified element into this heap.
+func (h *BinaryHeap[T]) Push(v T) {
+	h.items = append(h.items, v)
+	h.siftUp(len(h.items) - 1)
+}
+
+// Pop retrieves and removes the top (smallest) element of this heap.
+func (h *BinaryHeap[T]) Pop() (T, bool) {
+	if len(h.items) == 0 {
+		var zeroVal T
+		return zeroVal, false
+	}
+
+	top := h.items[0]
+	lastIndex := len(h.items) - 1
+	h.items[0] = h.items[lastIndex]
+	var zeroVal T
+	h.items[lastIndex] = zeroVal
+	h.items = h.items[:lastIndex]
+	h.siftDown(0)
+	return top, true
+}
+
+// Peek retrieves, but does not remove, the top (smallest) element of this heap.
+func (h *BinaryHeap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		var zeroVal T
+		return zeroVal, false
+	}
+	return h.items[0], true
+}
+
+// PeekMany retrieves, but does not remove, the n top elements of this heap sorted by priority.
+// Takes O(n log n) time, regardless of the heap's size.
+func (h *BinaryHeap[T]) PeekMany(n int) []T {
+	if n <= 0 || len(h.items) == 0 {
+		return nil
+	}
+
+	n = min(n, len(h.items))
+	buf := make([]T, 0, n)
+	// candidates holds heap indexes, ordered by the elements they point to
+	candidates := NewBinaryHeap[int](func(a, b int) int {
+		return h.comparator(h.items[a], h.items[b])
+	})
+	candidates.Push(0)
+	for len(buf) < n {
+		index, _ := candidates.Pop()
+		buf = append(buf, h.items[index])
+		if left := 2*index + 1; left < len(h.items) {
+			candidates.Push(left)
+		}
+		if right := 2*index + 2; right < len(h.items) {
+			candidates.Push(right)
+		}
+	}
+	return buf
+}
+
+// Len returns the number of elements in this heap.
+func (h *BinaryHeap[T]) Len() int {
+	return len(h.items)
+}
+
+// IsEmpty returns true if this heap contains no elements.
+func (h *BinaryHeap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+// Clear removes all the elements from this heap.
+func (h *BinaryHeap[T]) Clear() {
+	clear(h.items)
+	h.items = h.items[:0]
+}
+
+// ToSlice returns a copy of the elements from this heap, in heap (not sorted) order.
+func (h *BinaryHeap[T]) ToSlice() []T {
+	if len(h.items) == 0 {
+		return nil
+	}
+	buf := make([]T, len(h.items))
+	copy(buf, h.items)
+	return buf
+}
+
+// ForEach traverses through all the elements from this heap, in heap (not sorted) order.
+// Use predicate's return value to indicate a break of the iteration, TRUE meaning a break.
+func (h *BinaryHeap[T]) ForEach(predicateFunc collection.IterablePredicateFunc[T]) {
+	for _, item := range h.items {
+		if willBreak := predicateFunc(item); willBreak {
+			break
+		}
+	}
+}
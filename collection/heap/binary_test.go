@@ -0,0 +1,64 @@
+package heap_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/heap"
+	"github.com/neutrinocorp/nolan/collection/list"
+)
+
+func TestBinaryHeap(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	src := make([]int, 500)
+	for i := range src {
+		src[i] = rnd.Intn(100)
+	}
+	exp := make([]int, len(src))
+	copy(exp, src)
+	sort.Ints(exp)
+
+	tests := []struct {
+		name string
+		heap *heap.BinaryHeap[int]
+	}{
+		{
+			name: "from slice",
+			heap: heap.NewBinaryHeapFromSlice[int](func(a, b int) int { return a - b }, src),
+		},
+		{
+			name: "from collection",
+			heap: heap.NewBinaryHeapFromCollection[int](func(a, b int) int { return a - b }, list.NewSliceList(src)),
+		},
+		{
+			name: "pushed",
+			heap: func() *heap.BinaryHeap[int] {
+				h := heap.NewOrderedBinaryHeap[int]()
+				for _, item := range src {
+					h.Push(item)
+				}
+				return h
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, exp[:10], tt.heap.PeekMany(10))
+			assert.Equal(t, len(src), tt.heap.Len())
+			out := make([]int, 0, len(src))
+			for !tt.heap.IsEmpty() {
+				item, ok := tt.heap.Pop()
+				assert.True(t, ok)
+				out = append(out, item)
+			}
+			assert.Equal(t, exp, out)
+			_, ok := tt.heap.Pop()
+			assert.False(t, ok)
+			assert.Nil(t, tt.heap.PeekMany(1))
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package heap
+
+import "errors"
+
+var (
+	ErrStaleHandle      = errors.New("nolan.heap: handle was already removed from its heap")
+	ErrPriorityIncrease = errors.New("nolan.heap: new priority is greater than the current one")
+)
@@ -1,3 +1,291 @@
 package heap
 
-// TODO: IMPLEMENT ME
+import (
+	"github.com/neutrinocorp/nolan/collection"
+)
+
+// FibonacciHandle is a reference to an entry of a FibonacciHeap, returned on insertion.
+// Use it to update (FibonacciHeap.DecreaseKey) or remove (FibonacciHeap.Delete) the entry afterward.
+type FibonacciHandle[P, V any] struct {
+	parent   *FibonacciHandle[P, V]
+	child    *FibonacciHandle[P, V]
+	left     *FibonacciHandle[P, V]
+	right    *FibonacciHandle[P, V]
+	priority P
+	value    V
+	degree   int
+	marked   bool
+	removed  bool
+	// forcedMin makes this entry compare as the smallest one, used by FibonacciHeap.Delete.
+	forcedMin bool
+}
+
+// Priority returns the priority of this entry.
+func (h *FibonacciHandle[P, V]) Priority() P {
+	return h.priority
+}
+
+// Value returns the value of this entry.
+func (h *FibonacciHandle[P, V]) Value() V {
+	return h.value
+}
+
+// FibonacciHeap is a mergeable priority queue made of a collection of heap-ordered trees. Entries are values (V)
+// associated with a priority (P); the entry whose priority compares as the smallest one by the heap's
+// collection.ComparatorFunc is always at the top (i.e., a min-heap).
+//
+// Insert, Min, DecreaseKey and Meld take O(1) amortized time while ExtractMin and Delete take O(log n) amortized
+// time, making it suitable for graph algorithms relying on decrease-key operations (e.g., Dijkstra, Prim).
+type FibonacciHeap[P, V any] struct {
+	min        *FibonacciHandle[P, V]
+	comparator collection.ComparatorFunc[P]
+	len        int
+}
+
+// NewFibonacciHeap allocates a new FibonacciHeap instance ordering priorities with the given comparator.
+func NewFibonacciHeap[P, V any](comparator collection.ComparatorFunc[P]) *FibonacciHeap[P, V] {
+	if comparator == nil {
+		panic("comparator cannot be nil")
+	}
+	return &FibonacciHeap[P, V]{
+		comparator: comparator,
+	}
+}
+
+// NewOrderedFibonacciHeap allocates a new FibonacciHeap instance ordering priorities by their natural order
+// (see collection.Compare).
+func NewOrderedFibonacciHeap[P collection.Ordered, V any]() *FibonacciHeap[P, V] {
+	return NewFibonacciHeap[P, V](collection.Compare[P])
+}
+
+func (f *FibonacciHeap[P, V]) less(a, b *FibonacciHandle[P, V]) bool {
+	if a.forcedMin {
+		return true
+	} else if b.forcedMin {
+		return false
+	}
+	return f.comparator(a.priority, b.priority) < 0
+}
+
+// addRoot splices a detached node into the root list, updating the minimum if required.
+func (f *FibonacciHeap[P, V]) addRoot(node *FibonacciHandle[P, V]) {
+	node.parent = nil
+	if f.min == nil {
+		node.left, node.right = node, node
+		f.min = node
+		return
+	}
+	node.left = f.min
+	node.right = f.min.right
+	f.min.right.left = node
+	f.min.right = node
+	if f.less(node, f.min) {
+		f.min = node
+	}
+}
+
+// siblingsOf returns the nodes of the circular list containing node.
+func siblingsOf[P, V any](node *FibonacciHandle[P, V]) []*FibonacciHandle[P, V] {
+	if node == nil {
+		return nil
+	}
+	buf := []*FibonacciHandle[P, V]{node}
+	for current := node.right; current != node; current = current.right {
+		buf = append(buf, current)
+	}
+	return buf
+}
+
+// link makes child a child of parent. Both nodes are expected to be roots.
+func (f *FibonacciHeap[P, V]) link(child, parent *FibonacciHandle[P, V]) {
+	child.parent = parent
+	child.marked = false
+	if parent.child == nil {
+		child.left, child.right = child, child
+		parent.child = child
+	} else {
+		child.left = parent.child
+		child.right = parent.child.right
+		parent.child.right.left = child
+		parent.child.right = child
+	}
+	parent.degree++
+}
+
+// consolidate merges roots of equal degree until every root has a distinct degree.
+func (f *FibonacciHeap[P, V]) consolidate() {
+	roots := siblingsOf(f.min)
+	var degrees []*FibonacciHandle[P, V]
+	for _, root := range roots {
+		root.left, root.right = root, root
+		current := root
+		degree := current.degree
+		for degree < len(degrees) && degrees[degree] != nil {
+			other := degrees[degree]
+			if f.less(other, current) {
+				current, other = other, current
+			}
+			f.link(other, current)
+			degrees[degree] = nil
+			degree++
+		}
+		for len(degrees) <= degree {
+			degrees = append(degrees, nil)
+		}
+		degrees[degree] = current
+	}
+
+	f.min = nil
+	for _, root := range degrees {
+		if root != nil {
+			f.addRoot(root)
+		}
+	}
+}
+
+// cut moves node from its parent's children list into the root list.
+func (f *FibonacciHeap[P, V]) cut(node, parent *FibonacciHandle[P, V]) {
+	if node.right == node {
+		parent.child = nil
+	} else {
+		if parent.child == node {
+			parent.child = node.right
+		}
+		node.left.right = node.right
+		node.right.left = node.left
+	}
+	parent.degree--
+	node.marked = false
+	f.addRoot(node)
+}
+
+func (f *FibonacciHeap[P, V]) cascadingCut(node *FibonacciHandle[P, V]) {
+	for parent := node.parent; parent != nil; node, parent = parent, parent.parent {
+		if !node.marked {
+			node.marked = true
+			return
+		}
+		f.cut(node, parent)
+	}
+}
+
+// Insert inserts a value with the given priority into this heap, returning a handle to the new entry.
+func (f *FibonacciHeap[P, V]) Insert(priority P, val V) *FibonacciHandle[P, V] {
+	node := &FibonacciHandle[P, V]{
+		priority: priority,
+		value:    val,
+	}
+	f.addRoot(node)
+	f.len++
+	return node
+}
+
+// Min retrieves, but does not remove, the entry with the smallest priority of this heap.
+func (f *FibonacciHeap[P, V]) Min() (*FibonacciHandle[P, V], bool) {
+	return f.min, f.min != nil
+}
+
+// ExtractMin retrieves and removes the entry with the smallest priority of this heap.
+func (f *FibonacciHeap[P, V]) ExtractMin() (*FibonacciHandle[P, V], bool) {
+	minNode := f.min
+	if minNode == nil {
+		return nil, false
+	}
+
+	for _, child := range siblingsOf(minNode.child) {
+		f.addRoot(child)
+	}
+	if minNode.right == minNode {
+		f.min = nil
+	} else {
+		minNode.left.right = minNode.right
+		minNode.right.left = minNode.left
+		f.min = minNode.right
+		f.consolidate()
+	}
+	f.len--
+
+	minNode.parent, minNode.child, minNode.left, minNode.right = nil, nil, nil, nil
+	minNode.degree = 0
+	minNode.removed = true
+	return minNode, true
+}
+
+// DecreaseKey lowers the priority of the given entry.
+// Returns ErrPriorityIncrease if the new priority is greater than the current one or ErrStaleHandle if the entry
+// was already removed.
+func (f *FibonacciHeap[P, V]) DecreaseKey(handle *FibonacciHandle[P, V], priority P) error {
+	if handle.removed {
+		return ErrStaleHandle
+	} else if f.comparator(priority, handle.priority) > 0 {
+		return ErrPriorityIncrease
+	}
+
+	handle.priority = priority
+	f.moveUp(handle)
+	return nil
+}
+
+// moveUp restores heap order after node's priority decreased.
+func (f *FibonacciHeap[P, V]) moveUp(node *FibonacciHandle[P, V]) {
+	if parent := node.parent; parent != nil && f.less(node, parent) {
+		f.cut(node, parent)
+		f.cascadingCut(parent)
+	}
+	if f.less(node, f.min) {
+		f.min = node
+	}
+}
+
+// Delete removes the given entry from this heap. Returns ErrStaleHandle if the entry was already removed.
+func (f *FibonacciHeap[P, V]) Delete(handle *FibonacciHandle[P, V]) error {
+	if handle.removed {
+		return ErrStaleHandle
+	}
+
+	handle.forcedMin = true
+	f.moveUp(handle)
+	f.ExtractMin()
+	handle.forcedMin = false
+	return nil
+}
+
+// Meld moves all the entries from other into this heap in O(1) time, leaving other empty.
+// Handles of other's entries remain valid for this heap. Priorities are compared using this heap's comparator.
+func (f *FibonacciHeap[P, V]) Meld(other *FibonacciHeap[P, V]) {
+	if other == nil || other == f || other.min == nil {
+		return
+	}
+
+	if f.min == nil {
+		f.min = other.min
+	} else {
+		minRight, otherMinLeft := f.min.right, other.min.left
+		f.min.right = other.min
+		other.min.left = f.min
+		otherMinLeft.right = minRight
+		minRight.left = otherMinLeft
+		if f.less(other.min, f.min) {
+			f.min = other.min
+		}
+	}
+	f.len += other.len
+	other.min = nil
+	other.len = 0
+}
+
+// Len returns the number of entries in this heap.
+func (f *FibonacciHeap[P, V]) Len() int {
+	return f.len
+}
+
+// IsEmpty returns true if this heap contains no entries.
+func (f *FibonacciHeap[P, V]) IsEmpty() bool {
+	return f.len == 0
+}
+
+// Clear removes all the entries from this heap. Handles of cleared entries must not be used afterward.
+func (f *FibonacciHeap[P, V]) Clear() {
+	f.min = nil
+	f.len = 0
+}
@@ -0,0 +1,83 @@
+package heap_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutrinocorp/nolan/collection/heap"
+)
+
+func TestFibonacciHeap(t *testing.T) {
+	rnd := rand.New(rand.NewSource(11))
+	h := heap.NewOrderedFibonacciHeap[int, string]()
+	handles := make([]*heap.FibonacciHandle[int, string], 0)
+	for i := 0; i < 300; i++ {
+		handles = append(handles, h.Insert(rnd.Intn(1000), "val"))
+	}
+
+	// trigger consolidation so following operations work over non-trivial trees
+	first, ok := h.ExtractMin()
+	require.True(t, ok)
+	assert.ErrorIs(t, h.DecreaseKey(first, 0), heap.ErrStaleHandle)
+	assert.ErrorIs(t, h.Delete(first), heap.ErrStaleHandle)
+
+	alive := make(map[*heap.FibonacciHandle[int, string]]struct{})
+	for _, handle := range handles {
+		if handle != first {
+			alive[handle] = struct{}{}
+		}
+	}
+	for handle := range alive {
+		switch rnd.Intn(4) {
+		case 0:
+			assert.NoError(t, h.DecreaseKey(handle, handle.Priority()-rnd.Intn(500)))
+		case 1:
+			assert.NoError(t, h.Delete(handle))
+			delete(alive, handle)
+		case 2:
+			assert.ErrorIs(t, h.DecreaseKey(handle, handle.Priority()+1), heap.ErrPriorityIncrease)
+		}
+	}
+
+	exp := make([]int, 0, len(alive))
+	for handle := range alive {
+		exp = append(exp, handle.Priority())
+	}
+	sort.Ints(exp)
+	require.Equal(t, len(exp), h.Len())
+	out := make([]int, 0, len(exp))
+	for !h.IsEmpty() {
+		handle, _ := h.ExtractMin()
+		out = append(out, handle.Priority())
+	}
+	assert.Equal(t, exp, out)
+	_, ok = h.Min()
+	assert.False(t, ok)
+}
+
+func TestFibonacciHeap_Meld(t *testing.T) {
+	a := heap.NewOrderedFibonacciHeap[int, string]()
+	b := heap.NewOrderedFibonacciHeap[int, string]()
+	a.Insert(5, "a5")
+	a.Insert(3, "a3")
+	b.Insert(4, "b4")
+	bHandle := b.Insert(9, "b9")
+	b.Insert(1, "b1")
+
+	a.Meld(b)
+	assert.Equal(t, 5, a.Len())
+	assert.True(t, b.IsEmpty())
+	assert.NoError(t, a.DecreaseKey(bHandle, 2))
+
+	exp := []string{"b1", "b9", "a3", "b4", "a5"}
+	for _, val := range exp {
+		handle, ok := a.ExtractMin()
+		assert.True(t, ok)
+		assert.Equal(t, val, handle.Value())
+	}
+	assert.Equal(t, 0, a.Len())
+}
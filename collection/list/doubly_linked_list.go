@@ -2,6 +2,7 @@ package list
 
 import (
 	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/function"
 )
 
 // doublyLinkedListNode is a structure used to hold a key and both, previous and next, pointer reference to neighbor
@@ -21,7 +22,10 @@ type DoublyLinkedList[T any] struct {
 	len  int
 }
 
-var _ List[string] = &DoublyLinkedList[string]{}
+var (
+	_ List[string]                           = &DoublyLinkedList[string]{}
+	_ collection.RemovableCollection[string] = &DoublyLinkedList[string]{}
+)
 
 // NewDoublyLinkedList allocates a new DoublyLinkedList instance.
 func NewDoublyLinkedList[T any]() *DoublyLinkedList[T] {
@@ -178,6 +182,30 @@ func (l *DoublyLinkedList[T]) ForEach(predicateFunc collection.IterablePredicate
 	}
 }
 
+// RemoveIf removes all the elements matching predicateFunc (i.e., the ones it returns TRUE for) from this collection
+// in a single pass. Returns true if any element was removed.
+func (l *DoublyLinkedList[T]) RemoveIf(predicateFunc function.PredicateFunc[T]) bool {
+	wasMod := false
+	for currentNode := l.head; currentNode != nil; currentNode = currentNode.next {
+		if !predicateFunc(currentNode.key) {
+			continue
+		}
+		if currentNode.previous != nil {
+			currentNode.previous.next = currentNode.next
+		} else {
+			l.head = currentNode.next
+		}
+		if currentNode.next != nil {
+			currentNode.next.previous = currentNode.previous
+		} else {
+			l.tail = currentNode.previous
+		}
+		l.len--
+		wasMod = true
+	}
+	return wasMod
+}
+
 // AddAt inserts the specified element at the specified position in this list.
 func (l *DoublyLinkedList[T]) AddAt(index int, v T) {
 	if !isValidIndex(index, l.len) {
@@ -281,15 +309,15 @@ func (l *DoublyLinkedList[T]) ForEachWithIndex(predicateFunc collection.Iterable
 	}
 }
 
-// ToSubList returns a view of the portion of this list between the specified fromIndex, inclusive, and toIndex,
-// exclusive.
+// ToSubList returns the portion of this list between the specified fromIndex and toIndex, both inclusive. Returns nil
+// if the range is invalid.
 func (l *DoublyLinkedList[T]) ToSubList(fromIndex, toIndex int) List[T] {
 	if fromIndex < 0 || toIndex >= l.len || toIndex < fromIndex {
 		return nil
 	}
 
 	ls := &DoublyLinkedList[T]{}
-	currentNode := l.head
+	currentNode := l.getNodeAt(fromIndex)
 	for currentNode != nil && fromIndex <= toIndex {
 		ls.Add(currentNode.key)
 		currentNode = currentNode.next
@@ -0,0 +1,8 @@
+package list
+
+import "errors"
+
+var (
+	ErrImmutable          = errors.New("nolan.list: persistent collections cannot be mutated in place")
+	ErrTransientPersisted = errors.New("nolan.list: transient was already made persistent")
+)
@@ -26,7 +26,7 @@ type List[T any] interface {
 	// Use predicate's return value to indicate a break of the iteration.
 	// 'A' is the index while 'B' is the item.
 	ForEachWithIndex(predicateFunc collection.IterablePredicateBiFunc[int, T])
-	// ToSubList returns a view of the portion of this list between the specified fromIndex, inclusive, and toIndex,
-	// exclusive.
+	// ToSubList returns the portion of this list between the specified fromIndex and toIndex, both inclusive. Returns
+	// nil if the range is invalid.
 	ToSubList(fromIndex, toIndex int) List[T]
 }
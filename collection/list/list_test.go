@@ -1432,6 +1432,20 @@ func TestList_ToSubList(t *testing.T) {
 			factoryFunc:    slcLsFactoryFunc,
 			exp:            nil,
 		},
+		{
+			name:           "linked_ls multi middle",
+			in:             []int{1, 2, 3, 4},
+			subListIndexes: [2]int{1, 3},
+			factoryFunc:    linkedLsFactoryFunc,
+			exp:            []int{2, 3, 4},
+		},
+		{
+			name:           "slice_ls multi middle",
+			in:             []int{1, 2, 3, 4},
+			subListIndexes: [2]int{1, 3},
+			factoryFunc:    slcLsFactoryFunc,
+			exp:            []int{2, 3, 4},
+		},
 		{
 			name:           "slice_ls multi out of bounds",
 			in:             []int{1, 2, 3},
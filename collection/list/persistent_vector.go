@@ -0,0 +1,458 @@
+package list
+
+import (
+	"github.com/neutrinocorp/nolan/collection"
+)
+
+const (
+	vectorBits  = 5
+	vectorWidth = 1 << vectorBits
+	vectorMask  = vectorWidth - 1
+)
+
+// vectorEdit identifies the TransientVector owning a node, which may then be mutated in place.
+// It is not zero-sized, so every allocation yields a distinct pointer.
+type vectorEdit struct {
+	_ byte
+}
+
+// vectorNode is a node of the PersistentVector trie. Internal nodes hold up to 32 children while leaves hold up to
+// 32 elements.
+type vectorNode[T any] struct {
+	edit     *vectorEdit
+	children []*vectorNode[T]
+	values   []T
+}
+
+// editable returns n if it is owned by edit, or a copy of n owned by edit otherwise.
+// A nil edit (i.e., persistent operations) always copies the node.
+func (n *vectorNode[T]) editable(edit *vectorEdit) *vectorNode[T] {
+	if edit != nil && n.edit == edit {
+		return n
+	}
+	node := &vectorNode[T]{edit: edit}
+	if n.children != nil {
+		node.children = append(make([]*vectorNode[T], 0, len(n.children)+1), n.children...)
+	}
+	if n.values != nil {
+		node.values = append(make([]T, 0, len(n.values)), n.values...)
+	}
+	return node
+}
+
+// forEachLeaf calls predicateFunc with the elements of every leaf under n (at the given level), in order.
+// Returns true if predicateFunc asked to break the iteration.
+func (n *vectorNode[T]) forEachLeaf(level uint, predicateFunc func(values []T) bool) bool {
+	if level == 0 {
+		return predicateFunc(n.values)
+	}
+	for _, child := range n.children {
+		if child.forEachLeaf(level-vectorBits, predicateFunc) {
+			return true
+		}
+	}
+	return false
+}
+
+// newVectorPath returns a chain of single-child nodes from the given level down to leaf.
+func newVectorPath[T any](level uint, leaf *vectorNode[T], edit *vectorEdit) *vectorNode[T] {
+	if level == 0 {
+		return leaf
+	}
+	return &vectorNode[T]{
+		edit:     edit,
+		children: []*vectorNode[T]{newVectorPath(level-vectorBits, leaf, edit)},
+	}
+}
+
+// pushVectorLeaf appends leaf to the subtree of parent, which starts at index offset of the vector.
+func pushVectorLeaf[T any](level uint, parent, leaf *vectorNode[T], offset int,
+	edit *vectorEdit) *vectorNode[T] {
+	node := parent.editable(edit)
+	subIndex := (offset >> level) & vectorMask
+	if subIndex < len(node.children) {
+		node.children[subIndex] = pushVectorLeaf(level-vectorBits, node.children[subIndex], leaf, offset, edit)
+	} else {
+		node.children = append(node.children, newVectorPath(level-vectorBits, leaf, edit))
+	}
+	return node
+}
+
+// setVectorValue replaces the element at index within the subtree of n.
+func setVectorValue[T any](level uint, n *vectorNode[T], index int, v T, edit *vectorEdit) *vectorNode[T] {
+	node := n.editable(edit)
+	if level == 0 {
+		node.values[index&vectorMask] = v
+		return node
+	}
+	subIndex := (index >> level) & vectorMask
+	node.children[subIndex] = setVectorValue(level-vectorBits, node.children[subIndex], index, v, edit)
+	return node
+}
+
+// popVectorLeaf removes the last leaf, holding the element at lastIndex, from the subtree of n. Returns nil if the
+// subtree becomes empty.
+func popVectorLeaf[T any](level uint, n *vectorNode[T], lastIndex int, edit *vectorEdit) *vectorNode[T] {
+	subIndex := (lastIndex >> level) & vectorMask
+	if level > vectorBits {
+		child := popVectorLeaf(level-vectorBits, n.children[subIndex], lastIndex, edit)
+		if child == nil && subIndex == 0 {
+			return nil
+		}
+		node := n.editable(edit)
+		if child == nil {
+			node.children = node.children[:subIndex]
+		} else {
+			node.children[subIndex] = child
+		}
+		return node
+	} else if subIndex == 0 {
+		return nil
+	}
+	node := n.editable(edit)
+	node.children = node.children[:subIndex]
+	return node
+}
+
+// vectorTrie is the structure shared by PersistentVector and TransientVector. The last (up to 32) elements are kept
+// in a tail outside the trie, so appends only touch the trie once every 32 elements.
+type vectorTrie[T any] struct {
+	root  *vectorNode[T]
+	tail  []T
+	len   int
+	shift uint
+}
+
+func (t *vectorTrie[T]) tailOffset() int {
+	return t.len - len(t.tail)
+}
+
+// leafFor returns the elements of the leaf (or tail) holding the element at index.
+func (t *vectorTrie[T]) leafFor(index int) []T {
+	if index >= t.tailOffset() {
+		return t.tail
+	}
+	node := t.root
+	for level := t.shift; level > 0; level -= vectorBits {
+		node = node.children[(index>>level)&vectorMask]
+	}
+	return node.values
+}
+
+func (t *vectorTrie[T]) get(index int) T {
+	if !isValidIndex(index, t.len) {
+		var zeroVal T
+		return zeroVal
+	}
+	return t.leafFor(index)[index&vectorMask]
+}
+
+// pushTail moves the full tail into the trie as a new leaf, growing the trie by one level when the root is full.
+func (t *vectorTrie[T]) pushTail(edit *vectorEdit) {
+	leaf := &vectorNode[T]{edit: edit, values: t.tail}
+	offset := t.tailOffset()
+	switch {
+	case t.root == nil:
+		t.root = &vectorNode[T]{edit: edit, children: []*vectorNode[T]{leaf}}
+		t.shift = vectorBits
+	case offset>>vectorBits == 1<<t.shift:
+		t.root = &vectorNode[T]{
+			edit:     edit,
+			children: []*vectorNode[T]{t.root, newVectorPath(t.shift, leaf, edit)},
+		}
+		t.shift += vectorBits
+	default:
+		t.root = pushVectorLeaf(t.shift, t.root, leaf, offset, edit)
+	}
+}
+
+func (t *vectorTrie[T]) forEachChunk(predicateFunc func(offset int, values []T) bool) {
+	offset := 0
+	if t.root != nil {
+		isBreak := t.root.forEachLeaf(t.shift, func(values []T) bool {
+			if predicateFunc(offset, values) {
+				return true
+			}
+			offset += len(values)
+			return false
+		})
+		if isBreak {
+			return
+		}
+	}
+	if len(t.tail) > 0 {
+		predicateFunc(offset, t.tail)
+	}
+}
+
+// PersistentVector is the immutable, 32-way trie implementation of List. Every version of a vector is safe to share
+// across goroutines: Append, Set and Pop return new versions sharing most of their structure with the original one,
+// which is left untouched.
+//
+// PersistentVector satisfies List so it can be passed to read-only routines (e.g., IndexOf, IsSorted, BinarySearch).
+// However, every mutating List routine (i.e., Add, AddAll, AddSlice, Clear, AddAt, AddAllAt, SetAt and RemoveAt)
+// panics with ErrImmutable; thus, it must not be passed to routines mutating their input, such as Sort.
+//
+// GetAt, Set, Append and Pop take O(log32 n) time, which is effectively constant. Use a TransientVector for bulk
+// loads.
+//
+// Zero-value is an empty vector.
+type PersistentVector[T any] struct {
+	trie vectorTrie[T]
+}
+
+var _ List[string] = &PersistentVector[string]{}
+
+// NewPersistentVector allocates a new PersistentVector instance holding the given items.
+func NewPersistentVector[T any](items ...T) *PersistentVector[T] {
+	transient := (&PersistentVector[T]{}).Transient()
+	for _, item := range items {
+		transient.Append(item)
+	}
+	return transient.Persistent()
+}
+
+// Append returns a new version of this vector with v added at its end.
+func (v *PersistentVector[T]) Append(item T) *PersistentVector[T] {
+	trie := v.trie
+	if len(trie.tail) == vectorWidth {
+		trie.pushTail(nil)
+		trie.tail = nil
+	}
+	trie.tail = append(make([]T, 0, len(trie.tail)+1), trie.tail...)
+	trie.tail = append(trie.tail, item)
+	trie.len++
+	return &PersistentVector[T]{trie: trie}
+}
+
+// Set returns a new version of this vector with the element at the specified position replaced by item. Returns
+// this vector if index is out of range.
+func (v *PersistentVector[T]) Set(index int, item T) *PersistentVector[T] {
+	if !isValidIndex(index, v.trie.len) {
+		return v
+	}
+
+	trie := v.trie
+	if offset := trie.tailOffset(); index >= offset {
+		trie.tail = append(make([]T, 0, len(trie.tail)), trie.tail...)
+		trie.tail[index-offset] = item
+	} else {
+		trie.root = setVectorValue(trie.shift, trie.root, index, item, nil)
+	}
+	return &PersistentVector[T]{trie: trie}
+}
+
+// Pop returns a new version of this vector without its last element. Returns this vector if it is empty.
+func (v *PersistentVector[T]) Pop() *PersistentVector[T] {
+	switch {
+	case v.trie.len == 0:
+		return v
+	case v.trie.len == 1:
+		return &PersistentVector[T]{}
+	case len(v.trie.tail) > 1:
+		trie := v.trie
+		trie.tail = trie.tail[:len(trie.tail)-1]
+		trie.len--
+		return &PersistentVector[T]{trie: trie}
+	}
+
+	// the tail holds a single element; the last leaf of the trie becomes the new tail
+	trie := v.trie
+	lastIndex := trie.len - 2
+	trie.tail = trie.leafFor(lastIndex)
+	trie.root = popVectorLeaf(trie.shift, trie.root, lastIndex, nil)
+	if trie.root != nil && trie.shift > vectorBits && len(trie.root.children) == 1 {
+		trie.root = trie.root.children[0]
+		trie.shift -= vectorBits
+	}
+	trie.len--
+	return &PersistentVector[T]{trie: trie}
+}
+
+// Last returns the last element of this vector. Returns false if the vector is empty.
+func (v *PersistentVector[T]) Last() (T, bool) {
+	if v.trie.len == 0 {
+		var zeroVal T
+		return zeroVal, false
+	}
+	return v.trie.tail[len(v.trie.tail)-1], true
+}
+
+// Transient returns a TransientVector holding the elements of this vector, which is left untouched.
+func (v *PersistentVector[T]) Transient() *TransientVector[T] {
+	trie := v.trie
+	trie.tail = append(make([]T, 0, vectorWidth), trie.tail...)
+	return &TransientVector[T]{
+		trie: trie,
+		edit: &vectorEdit{},
+	}
+}
+
+// NewIterator returns a new collection.Iterator over the elements of this vector.
+func (v *PersistentVector[T]) NewIterator() collection.Iterator[T] {
+	return NewIterator[T](v)
+}
+
+// Add panics with ErrImmutable; use Append instead.
+func (v *PersistentVector[T]) Add(_ T) bool {
+	panic(ErrImmutable)
+}
+
+// AddAll panics with ErrImmutable; use a TransientVector instead.
+func (v *PersistentVector[T]) AddAll(_ collection.Collection[T]) bool {
+	panic(ErrImmutable)
+}
+
+// AddSlice panics with ErrImmutable; use a TransientVector instead.
+func (v *PersistentVector[T]) AddSlice(_ ...T) bool {
+	panic(ErrImmutable)
+}
+
+// Clear panics with ErrImmutable; use the zero-value instead.
+func (v *PersistentVector[T]) Clear() {
+	panic(ErrImmutable)
+}
+
+// Len returns the number of elements in this vector.
+func (v *PersistentVector[T]) Len() int {
+	return v.trie.len
+}
+
+// IsEmpty returns true if this vector contains no elements.
+func (v *PersistentVector[T]) IsEmpty() bool {
+	return v.trie.len == 0
+}
+
+// ToSlice returns all the elements from this vector as a new slice of T.
+func (v *PersistentVector[T]) ToSlice() []T {
+	buf := make([]T, 0, v.trie.len)
+	v.trie.forEachChunk(func(_ int, values []T) bool {
+		buf = append(buf, values...)
+		return false
+	})
+	return buf
+}
+
+// ForEach traverses through all the elements from this vector.
+// Use predicate's return value to indicate a break of the iteration, TRUE meaning a break.
+func (v *PersistentVector[T]) ForEach(predicateFunc collection.IterablePredicateFunc[T]) {
+	v.ForEachWithIndex(func(_ int, item T) bool {
+		return predicateFunc(item)
+	})
+}
+
+// AddAt panics with ErrImmutable.
+func (v *PersistentVector[T]) AddAt(_ int, _ T) {
+	panic(ErrImmutable)
+}
+
+// AddAllAt panics with ErrImmutable.
+func (v *PersistentVector[T]) AddAllAt(_ int, _ collection.Collection[T]) bool {
+	panic(ErrImmutable)
+}
+
+// SetAt panics with ErrImmutable; use Set instead.
+func (v *PersistentVector[T]) SetAt(_ int, _ T) T {
+	panic(ErrImmutable)
+}
+
+// GetAt returns the element at the specified position in this vector.
+func (v *PersistentVector[T]) GetAt(index int) T {
+	return v.trie.get(index)
+}
+
+// RemoveAt panics with ErrImmutable; use Pop to remove the last element instead.
+func (v *PersistentVector[T]) RemoveAt(_ int) T {
+	panic(ErrImmutable)
+}
+
+// ForEachWithIndex traverses through all the elements from this vector.
+// Use predicate's return value to indicate a break of the iteration.
+// 'A' is the index while 'B' is the item.
+func (v *PersistentVector[T]) ForEachWithIndex(predicateFunc collection.IterablePredicateBiFunc[int, T]) {
+	v.trie.forEachChunk(func(offset int, values []T) bool {
+		for i, item := range values {
+			if predicateFunc(offset+i, item) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// ToSubList returns a new PersistentVector holding the portion of this vector between the specified fromIndex and
+// toIndex, both inclusive, as SliceList.ToSubList does. Returns nil if the range is invalid.
+func (v *PersistentVector[T]) ToSubList(fromIndex, toIndex int) List[T] {
+	if fromIndex < 0 || toIndex >= v.trie.len || toIndex < fromIndex {
+		return nil
+	}
+	transient := (&PersistentVector[T]{}).Transient()
+	for i := fromIndex; i <= toIndex; i++ {
+		transient.Append(v.trie.get(i))
+	}
+	return transient.Persistent()
+}
+
+// TransientVector is a mutable builder of PersistentVector instances. It mutates in place the nodes it owns, only
+// copying the ones shared with persistent vectors, which makes bulk loads considerably cheaper than successive
+// PersistentVector.Append calls.
+//
+// A TransientVector is not safe for concurrent use, and it must not be used once Persistent was called.
+type TransientVector[T any] struct {
+	trie vectorTrie[T]
+	edit *vectorEdit
+}
+
+func (t *TransientVector[T]) ensureEditable() {
+	if t.edit == nil {
+		panic(ErrTransientPersisted)
+	}
+}
+
+// Append adds item at the end of this vector.
+func (t *TransientVector[T]) Append(item T) *TransientVector[T] {
+	t.ensureEditable()
+	if len(t.trie.tail) == vectorWidth {
+		t.trie.pushTail(t.edit)
+		t.trie.tail = make([]T, 0, vectorWidth)
+	}
+	t.trie.tail = append(t.trie.tail, item)
+	t.trie.len++
+	return t
+}
+
+// Set replaces the element at the specified position in this vector with item. Does nothing if index is out of
+// range.
+func (t *TransientVector[T]) Set(index int, item T) *TransientVector[T] {
+	t.ensureEditable()
+	if !isValidIndex(index, t.trie.len) {
+		return t
+	}
+
+	if offset := t.trie.tailOffset(); index >= offset {
+		t.trie.tail[index-offset] = item
+	} else {
+		t.trie.root = setVectorValue(t.trie.shift, t.trie.root, index, item, t.edit)
+	}
+	return t
+}
+
+// GetAt returns the element at the specified position in this vector.
+func (t *TransientVector[T]) GetAt(index int) T {
+	t.ensureEditable()
+	return t.trie.get(index)
+}
+
+// Len returns the number of elements in this vector.
+func (t *TransientVector[T]) Len() int {
+	t.ensureEditable()
+	return t.trie.len
+}
+
+// Persistent returns a PersistentVector holding the elements of this vector in O(1) time. This transient cannot be
+// used afterward.
+func (t *TransientVector[T]) Persistent() *PersistentVector[T] {
+	t.ensureEditable()
+	t.edit = nil
+	return &PersistentVector[T]{trie: t.trie}
+}
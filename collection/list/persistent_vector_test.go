@@ -0,0 +1,147 @@
+package list_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+)
+
+func TestPersistentVector_Append(t *testing.T) {
+	// large enough to grow the trie past two levels
+	const n = 40000
+	versions := make([]*list.PersistentVector[int], 0, n+1)
+	vec := &list.PersistentVector[int]{}
+	versions = append(versions, vec)
+	for i := 0; i < n; i++ {
+		vec = vec.Append(i)
+		versions = append(versions, vec)
+	}
+
+	assert.Equal(t, n, vec.Len())
+	for i := 0; i < n; i++ {
+		assert.Equal(t, i, vec.GetAt(i))
+	}
+	// older versions are left untouched
+	for _, size := range []int{0, 1, 32, 33, 1056, 1057, 32800} {
+		assert.Equal(t, size, versions[size].Len())
+		if size > 0 {
+			last, ok := versions[size].Last()
+			assert.True(t, ok)
+			assert.Equal(t, size-1, last)
+		}
+	}
+	assert.Equal(t, 0, vec.GetAt(n))
+	assert.Equal(t, 0, vec.GetAt(-1))
+}
+
+func TestPersistentVector_SetAndPop(t *testing.T) {
+	const n = 2000
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+	vec := list.NewPersistentVector(items...)
+
+	updated := vec.Set(5, -5).Set(n-1, -1)
+	assert.Equal(t, -5, updated.GetAt(5))
+	assert.Equal(t, -1, updated.GetAt(n-1))
+	assert.Equal(t, 5, vec.GetAt(5))
+	assert.Equal(t, n-1, vec.GetAt(n-1))
+	assert.Same(t, vec, vec.Set(n, 0))
+
+	popped := vec
+	for i := n; i > 0; i-- {
+		assert.Equal(t, i, popped.Len())
+		last, _ := popped.Last()
+		assert.Equal(t, i-1, last)
+		popped = popped.Pop()
+	}
+	assert.True(t, popped.IsEmpty())
+	assert.True(t, popped.Pop().IsEmpty())
+	_, ok := popped.Last()
+	assert.False(t, ok)
+	assert.Equal(t, items, vec.ToSlice())
+
+	// popping down then appending must not leak elements into the original version
+	shrunk := vec.Pop().Pop().Append(-2)
+	assert.Equal(t, -2, shrunk.GetAt(n-2))
+	assert.Equal(t, n-2, vec.GetAt(n-2))
+}
+
+func TestPersistentVector_Transient(t *testing.T) {
+	vec := list.NewPersistentVector(1, 2, 3)
+	transient := vec.Transient()
+	for i := 4; i <= 100; i++ {
+		transient.Append(i)
+	}
+	transient.Set(0, -1).Set(99, -100)
+	assert.Equal(t, 100, transient.Len())
+	assert.Equal(t, -1, transient.GetAt(0))
+
+	built := transient.Persistent()
+	assert.Panics(t, func() {
+		transient.Append(101)
+	})
+	assert.Equal(t, 100, built.Len())
+	assert.Equal(t, -1, built.GetAt(0))
+	assert.Equal(t, 50, built.GetAt(49))
+	assert.Equal(t, -100, built.GetAt(99))
+	assert.Equal(t, []int{1, 2, 3}, vec.ToSlice())
+
+	// a new transient must copy the nodes owned by the persisted one
+	again := built.Transient().Set(0, 0).Set(50, 0).Persistent()
+	assert.Equal(t, -1, built.GetAt(0))
+	assert.Equal(t, 51, built.GetAt(50))
+	assert.Equal(t, 0, again.GetAt(50))
+}
+
+func TestPersistentVector_List(t *testing.T) {
+	var ls list.List[string] = list.NewPersistentVector("a", "b", "c", "d")
+	buf := make([]string, 0)
+	ls.ForEachWithIndex(func(index int, item string) bool {
+		buf = append(buf, item)
+		return index == 2
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, buf)
+	// toIndex is inclusive, matching SliceList
+	assert.Equal(t, []string{"b", "c", "d"}, ls.ToSubList(1, 3).ToSlice())
+	assert.Equal(t, list.NewSliceList([]string{"a", "b", "c", "d"}).ToSubList(1, 3).ToSlice(),
+		ls.ToSubList(1, 3).ToSlice())
+	assert.Equal(t, []string{"a"}, ls.ToSubList(0, 0).ToSlice())
+	assert.Nil(t, ls.ToSubList(2, 4))
+	assert.Nil(t, ls.ToSubList(2, 1))
+
+	iter := ls.NewIterator()
+	assert.Equal(t, "a", iter.Next())
+	assert.Equal(t, "d", iter.Previous())
+
+	// read-only List routines accept vectors
+	assert.Equal(t, 2, list.IndexOf(ls, "c"))
+	assert.True(t, list.IsSorted(ls, collection.Compare[string]))
+	index, ok := list.BinarySearch(ls, "d", collection.Compare[string])
+	assert.True(t, ok)
+	assert.Equal(t, 3, index)
+}
+
+func TestPersistentVector_MutatorsPanic(t *testing.T) {
+	var ls list.List[string] = list.NewPersistentVector("a", "b")
+	mutators := map[string]func(){
+		"Add":      func() { ls.Add("c") },
+		"AddAll":   func() { ls.AddAll(list.NewSliceList([]string{"c"})) },
+		"AddSlice": func() { ls.AddSlice("c") },
+		"Clear":    func() { ls.Clear() },
+		"AddAt":    func() { ls.AddAt(0, "c") },
+		"AddAllAt": func() { ls.AddAllAt(0, list.NewSliceList([]string{"c"})) },
+		"SetAt":    func() { ls.SetAt(0, "c") },
+		"RemoveAt": func() { ls.RemoveAt(0) },
+	}
+	for name, mutatorFunc := range mutators {
+		t.Run(name, func(t *testing.T) {
+			assert.PanicsWithValue(t, list.ErrImmutable, mutatorFunc)
+			assert.Equal(t, []string{"a", "b"}, ls.ToSlice())
+		})
+	}
+}
@@ -0,0 +1,14 @@
+//go:build go1.23
+
+package list
+
+import "iter"
+
+// NewSliceListFromSeq allocates a new SliceList instance holding the elements yielded by seq, in order.
+func NewSliceListFromSeq[T any](seq iter.Seq[T]) *SliceList[T] {
+	ls := NewSliceList[T](nil)
+	for v := range seq {
+		ls.Add(v)
+	}
+	return ls
+}
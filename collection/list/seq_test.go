@@ -0,0 +1,17 @@
+//go:build go1.23
+
+package list_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/list"
+)
+
+func TestNewSliceListFromSeq(t *testing.T) {
+	assert.True(t, list.NewSliceListFromSeq(slices.Values([]int(nil))).IsEmpty())
+	assert.Equal(t, []int{3, 1, 3}, list.NewSliceListFromSeq(slices.Values([]int{3, 1, 3})).ToSlice())
+}
@@ -2,6 +2,7 @@ package list
 
 import (
 	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/function"
 )
 
 // SliceList is the Go's slice implementation of List. The user of this interface has precise control
@@ -12,7 +13,8 @@ type SliceList[T any] struct {
 }
 
 var (
-	_ List[int] = &SliceList[int]{}
+	_ List[int]                           = &SliceList[int]{}
+	_ collection.RemovableCollection[int] = &SliceList[int]{}
 )
 
 // NewSliceList allocates a new SliceList instance.
@@ -114,6 +116,24 @@ func (s *SliceList[T]) ForEach(predicateFunc collection.IterablePredicateFunc[T]
 	}
 }
 
+// RemoveIf removes all the elements matching predicateFunc (i.e., the ones it returns TRUE for) from this collection
+// in a single pass, keeping the order of the remaining ones. Returns true if any element was removed.
+func (s *SliceList[T]) RemoveIf(predicateFunc function.PredicateFunc[T]) bool {
+	buf := s.Source[:0]
+	for _, item := range s.Source {
+		if !predicateFunc(item) {
+			buf = append(buf, item)
+		}
+	}
+	if len(buf) == len(s.Source) {
+		return false
+	}
+	// zero out the tail so removed elements can be garbage collected
+	clear(s.Source[len(buf):])
+	s.Source = buf
+	return true
+}
+
 // AddAt inserts the specified element at the specified position in this list.
 func (s *SliceList[T]) AddAt(index int, v T) {
 	if !isValidIndex(index, len(s.Source)) {
@@ -184,8 +204,8 @@ func (s *SliceList[T]) ForEachWithIndex(predicateFunc collection.IterablePredica
 	}
 }
 
-// ToSubList returns a view of the portion of this list between the specified fromIndex, inclusive, and toIndex,
-// exclusive.
+// ToSubList returns a view of the portion of this list between the specified fromIndex and toIndex, both inclusive.
+// Returns nil if the range is invalid.
 func (s *SliceList[T]) ToSubList(fromIndex, toIndex int) List[T] {
 	if fromIndex < 0 || toIndex >= len(s.Source) || toIndex < fromIndex {
 		return nil
@@ -0,0 +1,168 @@
+package list
+
+import (
+	"sort"
+
+	"github.com/neutrinocorp/nolan/collection"
+)
+
+// Sort sorts the elements of ls in ascending order as determined by comparator. The sort is not guaranteed to be
+// stable: equal elements may be reversed from their original order (use StableSort to keep it).
+//
+// SliceList is sorted in place with pattern-defeating quicksort and DoublyLinkedList by relinking its nodes with
+// merge sort, both in O(n log n) time. Other implementations are sorted through a copy written back with SetAt.
+func Sort[T any](ls List[T], comparator collection.ComparatorFunc[T]) {
+	sortList(ls, comparator, sort.Slice)
+}
+
+// StableSort sorts the elements of ls in ascending order as determined by comparator, keeping the original order of
+// equal elements.
+//
+// SliceList is sorted in place with insertion-merge sort, taking O(n log^2 n) time, and DoublyLinkedList by relinking
+// its nodes with merge sort, taking O(n log n) time. Other implementations are sorted through a copy written back with
+// SetAt.
+func StableSort[T any](ls List[T], comparator collection.ComparatorFunc[T]) {
+	sortList(ls, comparator, sort.SliceStable)
+}
+
+func sortList[T any](ls List[T], comparator collection.ComparatorFunc[T],
+	sortFunc func(x any, less func(i, j int) bool)) {
+	if comparator == nil {
+		panic("comparator cannot be nil")
+	}
+
+	switch src := ls.(type) {
+	case *SliceList[T]:
+		sortFunc(src.Source, func(i, j int) bool {
+			return comparator(src.Source[i], src.Source[j]) < 0
+		})
+	case *DoublyLinkedList[T]:
+		src.mergeSort(comparator)
+	default:
+		buf := make([]T, 0, ls.Len())
+		buf = append(buf, ls.ToSlice()...)
+		sortFunc(buf, func(i, j int) bool {
+			return comparator(buf[i], buf[j]) < 0
+		})
+		for i, v := range buf {
+			ls.SetAt(i, v)
+		}
+	}
+}
+
+// mergeSort sorts this list by relinking its nodes with a stable, top-down merge sort.
+func (l *DoublyLinkedList[T]) mergeSort(comparator collection.ComparatorFunc[T]) {
+	if l.len < 2 {
+		return
+	}
+
+	l.head = mergeSortNodes(l.head, l.len, comparator)
+	// merging only maintains next references; restore previous ones and the tail
+	var previousNode *doublyLinkedListNode[T]
+	for currentNode := l.head; currentNode != nil; currentNode = currentNode.next {
+		currentNode.previous = previousNode
+		previousNode = currentNode
+	}
+	l.tail = previousNode
+}
+
+// mergeSortNodes sorts the chain of n nodes starting at head, returning the new head of the chain.
+func mergeSortNodes[T any](head *doublyLinkedListNode[T], n int,
+	comparator collection.ComparatorFunc[T]) *doublyLinkedListNode[T] {
+	if n < 2 {
+		head.next = nil
+		return head
+	}
+
+	middle := head
+	for i := 0; i < n/2; i++ {
+		middle = middle.next
+	}
+	right := mergeSortNodes(middle, n-n/2, comparator)
+	left := mergeSortNodes(head, n/2, comparator)
+
+	sentinel := doublyLinkedListNode[T]{}
+	tail := &sentinel
+	for left != nil && right != nil {
+		// taking from left on ties keeps the sort stable
+		if comparator(right.key, left.key) < 0 {
+			tail.next, right = right, right.next
+		} else {
+			tail.next, left = left, left.next
+		}
+		tail = tail.next
+	}
+	if left != nil {
+		tail.next = left
+	} else {
+		tail.next = right
+	}
+	return sentinel.next
+}
+
+// IsSorted returns true if the elements of ls are sorted in ascending order as determined by comparator.
+// Takes O(n) time.
+func IsSorted[T any](ls List[T], comparator collection.ComparatorFunc[T]) bool {
+	isSorted := true
+	var previous T
+	ls.ForEachWithIndex(func(index int, v T) bool {
+		if index > 0 && comparator(v, previous) < 0 {
+			isSorted = false
+			return true
+		}
+		previous = v
+		return false
+	})
+	return isSorted
+}
+
+// LowerBound returns the index of the first element of ls which does not compare less than v (i.e., the first
+// position v could be inserted at while keeping ls sorted), or ls.Len() if there is no such element.
+// ls must be sorted in ascending order as determined by comparator.
+//
+// Takes O(log n) time for lists with constant-time index access (e.g., SliceList). DoublyLinkedList is scanned
+// sequentially instead, taking O(n) time.
+func LowerBound[T any](ls List[T], v T, comparator collection.ComparatorFunc[T]) int {
+	return searchList(ls, func(elem T) bool {
+		return comparator(elem, v) >= 0
+	})
+}
+
+// UpperBound returns the index of the first element of ls which compares greater than v (i.e., the last position v
+// could be inserted at while keeping ls sorted), or ls.Len() if there is no such element.
+// ls must be sorted in ascending order as determined by comparator.
+//
+// Takes O(log n) time for lists with constant-time index access (e.g., SliceList). DoublyLinkedList is scanned
+// sequentially instead, taking O(n) time.
+func UpperBound[T any](ls List[T], v T, comparator collection.ComparatorFunc[T]) int {
+	return searchList(ls, func(elem T) bool {
+		return comparator(elem, v) > 0
+	})
+}
+
+// BinarySearch searches for v in ls, returning the index of its first occurrence and true if found, or the index it
+// could be inserted at (see LowerBound) and false if not found.
+// ls must be sorted in ascending order as determined by comparator.
+func BinarySearch[T any](ls List[T], v T, comparator collection.ComparatorFunc[T]) (int, bool) {
+	index := LowerBound(ls, v, comparator)
+	return index, index < ls.Len() && comparator(ls.GetAt(index), v) == 0
+}
+
+// searchList returns the smallest index at which predicateFunc is true, assuming it is false for a prefix of ls and
+// true for the remainder (see sort.Search).
+func searchList[T any](ls List[T], predicateFunc func(elem T) bool) int {
+	if _, ok := ls.(*DoublyLinkedList[T]); ok {
+		found := ls.Len()
+		ls.ForEachWithIndex(func(index int, elem T) bool {
+			if predicateFunc(elem) {
+				found = index
+				return true
+			}
+			return false
+		})
+		return found
+	}
+	return sort.Search(ls.Len(), func(i int) bool {
+		return predicateFunc(ls.GetAt(i))
+	})
+}
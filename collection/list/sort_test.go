@@ -0,0 +1,134 @@
+package list_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+)
+
+// record is used to verify sort stability: records are compared by key only.
+type record struct {
+	key, seq int
+}
+
+func compareRecords(a, b record) int {
+	return collection.Compare(a.key, b.key)
+}
+
+// customList is a List implementation unknown to the list package, sorted through the generic fallback.
+type customList[T any] struct {
+	*list.SliceList[T]
+}
+
+var sortFactories = []struct {
+	name        string
+	factoryFunc func(src []record) list.List[record]
+}{
+	{
+		name: "slice_ls",
+		factoryFunc: func(src []record) list.List[record] {
+			return list.NewSliceList(src)
+		},
+	},
+	{
+		name: "linked_ls",
+		factoryFunc: func(src []record) list.List[record] {
+			return list.NewDoublyLinkedListFromSlice(src)
+		},
+	},
+	{
+		name: "custom_ls",
+		factoryFunc: func(src []record) list.List[record] {
+			return customList[record]{SliceList: list.NewSliceList(src)}
+		},
+	},
+}
+
+func newRecords(n, keyRange int) []record {
+	r := rand.New(rand.NewSource(int64(n)))
+	buf := make([]record, n)
+	for i := range buf {
+		buf[i] = record{key: r.Intn(keyRange), seq: i}
+	}
+	return buf
+}
+
+func TestStableSort(t *testing.T) {
+	for _, factory := range sortFactories {
+		for _, n := range []int{0, 1, 2, 3, 17, 500} {
+			ls := factory.factoryFunc(newRecords(n, 10))
+			list.StableSort(ls, compareRecords)
+			assert.Equal(t, n, ls.Len(), factory.name)
+			assert.True(t, list.IsSorted(ls, compareRecords), factory.name)
+			items := ls.ToSlice()
+			for i := 1; i < len(items); i++ {
+				if items[i-1].key == items[i].key {
+					assert.Less(t, items[i-1].seq, items[i].seq, factory.name)
+				}
+			}
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	for _, factory := range sortFactories {
+		ls := factory.factoryFunc(newRecords(300, 1000))
+		assert.False(t, list.IsSorted(ls, compareRecords))
+		list.Sort(ls, compareRecords)
+		assert.True(t, list.IsSorted(ls, compareRecords), factory.name)
+		assert.Equal(t, 300, ls.Len())
+	}
+
+	// linked list nodes are relinked both ways
+	ls := list.NewDoublyLinkedListFromSlice([]int{3, 1, 2})
+	list.Sort[int](ls, collection.Compare[int])
+	iter := ls.NewIterator()
+	var reversed []int
+	for iter.HasPrevious() {
+		reversed = append(reversed, iter.Previous())
+	}
+	assert.Equal(t, []int{3, 2, 1}, reversed)
+	ls.Add(0)
+	assert.Equal(t, []int{1, 2, 3, 0}, ls.ToSlice())
+}
+
+func TestSearch(t *testing.T) {
+	src := []int{1, 3, 3, 3, 5, 8}
+	factories := map[string]list.List[int]{
+		"slice_ls":  list.NewSliceList(src),
+		"linked_ls": list.NewDoublyLinkedListFromSlice(src),
+	}
+	tests := []struct {
+		name          string
+		in            int
+		expLower      int
+		expUpper      int
+		expSearchFind bool
+	}{
+		{name: "before first", in: 0, expLower: 0, expUpper: 0},
+		{name: "first", in: 1, expLower: 0, expUpper: 1, expSearchFind: true},
+		{name: "duplicates", in: 3, expLower: 1, expUpper: 4, expSearchFind: true},
+		{name: "gap", in: 6, expLower: 5, expUpper: 5},
+		{name: "last", in: 8, expLower: 5, expUpper: 6, expSearchFind: true},
+		{name: "after last", in: 9, expLower: 6, expUpper: 6},
+	}
+
+	for name, ls := range factories {
+		for _, tt := range tests {
+			t.Run(name+" "+tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.expLower, list.LowerBound[int](ls, tt.in, collection.Compare[int]))
+				assert.Equal(t, tt.expUpper, list.UpperBound[int](ls, tt.in, collection.Compare[int]))
+				index, found := list.BinarySearch[int](ls, tt.in, collection.Compare[int])
+				assert.Equal(t, tt.expLower, index)
+				assert.Equal(t, tt.expSearchFind, found)
+			})
+		}
+	}
+	index, found := list.BinarySearch[int](list.NewSliceList[int](nil), 1, collection.Compare[int])
+	assert.Zero(t, index)
+	assert.False(t, found)
+}
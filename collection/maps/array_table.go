@@ -0,0 +1,232 @@
+package maps
+
+import (
+	"github.com/neutrinocorp/nolan/collection"
+)
+
+// ArrayTable is the dense, slice implementation of Table for row and column key sets known at creation time. Cells
+// are kept in a single row-major slice, indexed through a map per key set.
+//
+// Put, Get, Remove, ContainsRow and ContainsColumn take O(1) time. Row and Column views are traversed in O(n) time,
+// n being the number of columns or rows, respectively. Iteration routines follow the order of the keys given at
+// creation time.
+//
+// Keys out of the key sets cannot be stored: Put ignores them whereas PutSafe returns ErrUnknownTableKey.
+type ArrayTable[R, C comparable, V any] struct {
+	rowKeys     []R
+	columnKeys  []C
+	rowIndex    map[R]int
+	columnIndex map[C]int
+	values      []V
+	isSet       []bool
+	// rowCounts and columnCounts hold the number of mappings of each row and column, respectively.
+	rowCounts    []int
+	columnCounts []int
+	len          int
+}
+
+var _ Table[string, string, int] = &ArrayTable[string, string, int]{}
+
+// NewArrayTable allocates a new ArrayTable instance for the given row and column keys. Duplicated keys are ignored.
+func NewArrayTable[R, C comparable, V any](rowKeys []R, columnKeys []C) *ArrayTable[R, C, V] {
+	t := &ArrayTable[R, C, V]{
+		rowIndex:    make(map[R]int, len(rowKeys)),
+		columnIndex: make(map[C]int, len(columnKeys)),
+	}
+	for _, row := range rowKeys {
+		if _, ok := t.rowIndex[row]; !ok {
+			t.rowIndex[row] = len(t.rowKeys)
+			t.rowKeys = append(t.rowKeys, row)
+		}
+	}
+	for _, col := range columnKeys {
+		if _, ok := t.columnIndex[col]; !ok {
+			t.columnIndex[col] = len(t.columnKeys)
+			t.columnKeys = append(t.columnKeys, col)
+		}
+	}
+	cellCount := len(t.rowKeys) * len(t.columnKeys)
+	t.values = make([]V, cellCount)
+	t.isSet = make([]bool, cellCount)
+	t.rowCounts = make([]int, len(t.rowKeys))
+	t.columnCounts = make([]int, len(t.columnKeys))
+	return t
+}
+
+// RowKeys returns the row keys of this table, in creation order.
+func (t *ArrayTable[R, C, V]) RowKeys() []R {
+	return append([]R(nil), t.rowKeys...)
+}
+
+// ColumnKeys returns the column keys of this table, in creation order.
+func (t *ArrayTable[R, C, V]) ColumnKeys() []C {
+	return append([]C(nil), t.columnKeys...)
+}
+
+// cellIndex returns the position of the cell for the given keys within values, or false if any key is unknown.
+func (t *ArrayTable[R, C, V]) cellIndex(row R, col C) (int, bool) {
+	rowIndex, ok := t.rowIndex[row]
+	if !ok {
+		return 0, false
+	}
+	columnIndex, ok := t.columnIndex[col]
+	if !ok {
+		return 0, false
+	}
+	return rowIndex*len(t.columnKeys) + columnIndex, true
+}
+
+// PutSafe associates the specified value with the specified row and column keys in this table.
+// Returns ErrUnknownTableKey if any key is out of the key sets of this table.
+func (t *ArrayTable[R, C, V]) PutSafe(row R, col C, val V) error {
+	index, ok := t.cellIndex(row, col)
+	if !ok {
+		return ErrUnknownTableKey
+	}
+	if !t.isSet[index] {
+		t.isSet[index] = true
+		t.rowCounts[t.rowIndex[row]]++
+		t.columnCounts[t.columnIndex[col]]++
+		t.len++
+	}
+	t.values[index] = val
+	return nil
+}
+
+// Put associates the specified value with the specified row and column keys in this table. Does nothing if any key
+// is out of the key sets of this table.
+func (t *ArrayTable[R, C, V]) Put(row R, col C, val V) {
+	_ = t.PutSafe(row, col, val)
+}
+
+// Get returns the value to which the specified row and column keys are mapped.
+func (t *ArrayTable[R, C, V]) Get(row R, col C) (V, bool) {
+	if index, ok := t.cellIndex(row, col); ok && t.isSet[index] {
+		return t.values[index], true
+	}
+	var zeroVal V
+	return zeroVal, false
+}
+
+// Remove removes the mapping for the specified row and column keys from this table if it is present, returning its
+// value.
+func (t *ArrayTable[R, C, V]) Remove(row R, col C) V {
+	var zeroVal V
+	index, ok := t.cellIndex(row, col)
+	if !ok || !t.isSet[index] {
+		return zeroVal
+	}
+	val := t.values[index]
+	t.values[index] = zeroVal
+	t.isSet[index] = false
+	t.rowCounts[t.rowIndex[row]]--
+	t.columnCounts[t.columnIndex[col]]--
+	t.len--
+	return val
+}
+
+// Contains returns true if this table contains a mapping for the specified row and column keys.
+func (t *ArrayTable[R, C, V]) Contains(row R, col C) bool {
+	index, ok := t.cellIndex(row, col)
+	return ok && t.isSet[index]
+}
+
+// ContainsRow returns true if this table contains a mapping with the specified row key.
+func (t *ArrayTable[R, C, V]) ContainsRow(row R) bool {
+	rowIndex, ok := t.rowIndex[row]
+	return ok && t.rowCounts[rowIndex] > 0
+}
+
+// ContainsColumn returns true if this table contains a mapping with the specified column key.
+func (t *ArrayTable[R, C, V]) ContainsColumn(col C) bool {
+	columnIndex, ok := t.columnIndex[col]
+	return ok && t.columnCounts[columnIndex] > 0
+}
+
+// Row returns a live Map view of the mappings with the specified row key, keyed by column.
+func (t *ArrayTable[R, C, V]) Row(row R) Map[C, V] {
+	return tableLineView[C, V]{
+		getFunc: func(col C) (V, bool) {
+			return t.Get(row, col)
+		},
+		putFunc: func(col C, val V) {
+			t.Put(row, col, val)
+		},
+		removeFunc: func(col C) V {
+			return t.Remove(row, col)
+		},
+		forEachFunc: func(predicateFunc collection.IterablePredicateBiFunc[C, V]) {
+			rowIndex, ok := t.rowIndex[row]
+			if !ok {
+				return
+			}
+			offset := rowIndex * len(t.columnKeys)
+			for columnIndex, col := range t.columnKeys {
+				if t.isSet[offset+columnIndex] && predicateFunc(col, t.values[offset+columnIndex]) {
+					break
+				}
+			}
+		},
+	}
+}
+
+// Column returns a live Map view of the mappings with the specified column key, keyed by row.
+func (t *ArrayTable[R, C, V]) Column(col C) Map[R, V] {
+	return tableLineView[R, V]{
+		getFunc: func(row R) (V, bool) {
+			return t.Get(row, col)
+		},
+		putFunc: func(row R, val V) {
+			t.Put(row, col, val)
+		},
+		removeFunc: func(row R) V {
+			return t.Remove(row, col)
+		},
+		forEachFunc: func(predicateFunc collection.IterablePredicateBiFunc[R, V]) {
+			columnIndex, ok := t.columnIndex[col]
+			if !ok {
+				return
+			}
+			for rowIndex, row := range t.rowKeys {
+				index := rowIndex*len(t.columnKeys) + columnIndex
+				if t.isSet[index] && predicateFunc(row, t.values[index]) {
+					break
+				}
+			}
+		},
+	}
+}
+
+// CellSet returns a slice copy of all the cells contained in this table, in row-major order.
+func (t *ArrayTable[R, C, V]) CellSet() []Cell[R, C, V] {
+	buf := make([]Cell[R, C, V], 0, t.len)
+	for index, isSet := range t.isSet {
+		if isSet {
+			buf = append(buf, Cell[R, C, V]{
+				Row:    t.rowKeys[index/len(t.columnKeys)],
+				Column: t.columnKeys[index%len(t.columnKeys)],
+				Value:  t.values[index],
+			})
+		}
+	}
+	return buf
+}
+
+// Len returns the number of cells in this table.
+func (t *ArrayTable[R, C, V]) Len() int {
+	return t.len
+}
+
+// IsEmpty returns true if this table contains no cells.
+func (t *ArrayTable[R, C, V]) IsEmpty() bool {
+	return t.len == 0
+}
+
+// Clear removes all the cells from this table. Key sets are kept.
+func (t *ArrayTable[R, C, V]) Clear() {
+	clear(t.values)
+	clear(t.isSet)
+	clear(t.rowCounts)
+	clear(t.columnCounts)
+	t.len = 0
+}
@@ -1,3 +1,202 @@
 package maps
 
-// TODO: Implement me
+import (
+	"github.com/neutrinocorp/nolan/collection"
+)
+
+// ValueCollisionPolicy indicates how a BidiMap behaves when a value is put under a key while it is already bound to
+// a different key.
+type ValueCollisionPolicy uint8
+
+const (
+	// RejectValueCollision leaves the map untouched. BidiMap.PutSafe returns ErrValueAlreadyBound.
+	RejectValueCollision ValueCollisionPolicy = iota
+	// OverwriteValueCollision removes the previous key bound to the value before binding it to the new key.
+	OverwriteValueCollision
+	// KeepValueCollision silently keeps the previous binding, leaving the map untouched.
+	KeepValueCollision
+)
+
+// BidiMap is a bidirectional Map, a map that preserves the uniqueness of its values as well as that of its keys.
+// This constraint enables bidirectional maps to have an inverse view (see Inverse), another BidiMap with the same
+// entries as this one but with reversed keys and values.
+//
+// Lookups by either key (Get) or value (GetKey) take O(1) time as this implementation keeps two HashMap instances,
+// one for each direction.
+type BidiMap[K, V comparable] struct {
+	forward  HashMap[K, V]
+	backward HashMap[V, K]
+	policy   ValueCollisionPolicy
+	inverse  *BidiMap[V, K]
+}
+
+var _ Map[string, int] = &BidiMap[string, int]{}
+
+// NewBidiMap allocates a new BidiMap instance. 'policy' indicates how value collisions are handled.
+func NewBidiMap[K, V comparable](policy ValueCollisionPolicy) *BidiMap[K, V] {
+	bidiMap := &BidiMap[K, V]{
+		forward:  HashMap[K, V]{},
+		backward: HashMap[V, K]{},
+		policy:   policy,
+	}
+	bidiMap.inverse = &BidiMap[V, K]{
+		forward:  bidiMap.backward,
+		backward: bidiMap.forward,
+		policy:   policy,
+		inverse:  bidiMap,
+	}
+	return bidiMap
+}
+
+// Inverse returns a live inverse view of this map, where values are mapped to keys.
+// Changes in the view are reflected in this map and vice-versa.
+func (b *BidiMap[K, V]) Inverse() Map[V, K] {
+	return b.inverse
+}
+
+// PutSafe associates the specified value with the specified key in this map.
+// Returns ErrValueAlreadyBound if the value is bound to a different key and the collision policy is
+// RejectValueCollision.
+func (b *BidiMap[K, V]) PutSafe(key K, val V) error {
+	if prevKey, ok := b.backward[val]; ok && prevKey != key {
+		switch b.policy {
+		case OverwriteValueCollision:
+			delete(b.forward, prevKey)
+		case KeepValueCollision:
+			return nil
+		default:
+			return ErrValueAlreadyBound
+		}
+	}
+	if prevVal, ok := b.forward[key]; ok {
+		delete(b.backward, prevVal)
+	}
+	b.forward[key] = val
+	b.backward[val] = key
+	return nil
+}
+
+// GetKey returns the key to which the specified value is bound.
+func (b *BidiMap[K, V]) GetKey(val V) (K, bool) {
+	return b.backward.Get(val)
+}
+
+// ContainsValue returns true if this map contains a mapping for the specified value.
+func (b *BidiMap[K, V]) ContainsValue(val V) bool {
+	return b.backward.ContainsKey(val)
+}
+
+// RemoveValue removes the mapping for a value from this map if it is present, returning its key.
+func (b *BidiMap[K, V]) RemoveValue(val V) K {
+	return b.inverse.Remove(val)
+}
+
+// Get returns the value to which the specified key is mapped, or null if this map contains no mapping for the key.
+func (b *BidiMap[K, V]) Get(key K) (V, bool) {
+	return b.forward.Get(key)
+}
+
+// GetWithFallback returns the value to which the specified key is mapped, or fallbackValue if this map contains
+// no mapping for the key.
+func (b *BidiMap[K, V]) GetWithFallback(key K, fallbackValue V) V {
+	return b.forward.GetWithFallback(key, fallbackValue)
+}
+
+// Put associates the specified value with the specified key src this map.
+// Value collisions are handled according to the map's ValueCollisionPolicy; use PutSafe to detect rejections.
+func (b *BidiMap[K, V]) Put(key K, val V) {
+	_ = b.PutSafe(key, val)
+}
+
+// PutIfAbsent if the specified key is not already associated with a value (or is mapped to nil) associates
+// it with the given value and returns FALSE, else returns TRUE.
+func (b *BidiMap[K, V]) PutIfAbsent(key K, val V) bool {
+	if _, ok := b.forward[key]; ok {
+		return false
+	}
+	if prevKey, ok := b.backward[val]; ok && prevKey != key && b.policy != OverwriteValueCollision {
+		return false
+	}
+	return b.PutSafe(key, val) == nil
+}
+
+// PutAll copies all mappings from the specified map to this map.
+func (b *BidiMap[K, V]) PutAll(src Map[K, V]) {
+	src.ForEach(func(key K, val V) bool {
+		b.Put(key, val)
+		return false
+	})
+}
+
+// PutAllEntries copies all mappings from the slice of Entry(es) to this map.
+func (b *BidiMap[K, V]) PutAllEntries(entries ...Entry[K, V]) {
+	for _, entry := range entries {
+		b.Put(entry.Key, entry.Value)
+	}
+}
+
+// Remove removes the mapping for a key from this map if it is present.
+func (b *BidiMap[K, V]) Remove(key K) V {
+	val, ok := b.forward[key]
+	if !ok {
+		return val
+	}
+	delete(b.forward, key)
+	delete(b.backward, val)
+	return val
+}
+
+// Replace replaces the entry for the specified key only if it is currently mapped to some value.
+// Value collisions are handled according to the map's ValueCollisionPolicy.
+func (b *BidiMap[K, V]) Replace(key K, val V) bool {
+	if _, ok := b.forward[key]; !ok {
+		return false
+	}
+	if prevKey, ok := b.backward[val]; ok && prevKey != key && b.policy != OverwriteValueCollision {
+		return false
+	}
+	return b.PutSafe(key, val) == nil
+}
+
+// ContainsKey returns true if this map contains a mapping for the specified key.
+func (b *BidiMap[K, V]) ContainsKey(key K) bool {
+	return b.forward.ContainsKey(key)
+}
+
+// Len returns the number of key-value mappings src this map.
+func (b *BidiMap[K, V]) Len() int {
+	return b.forward.Len()
+}
+
+// Clear removes all mappings from this map.
+func (b *BidiMap[K, V]) Clear() {
+	b.forward.Clear()
+	b.backward.Clear()
+}
+
+// Keys returns a collection.Collection view of the keys contained src this map.
+func (b *BidiMap[K, V]) Keys() collection.Collection[K] {
+	return b.forward.Keys()
+}
+
+// Values returns a collection.Collection view of the values contained src this map.
+func (b *BidiMap[K, V]) Values() collection.Collection[V] {
+	return b.forward.Values()
+}
+
+// KeysSlice returns a slice view of the keys contained src this map.
+func (b *BidiMap[K, V]) KeysSlice() []K {
+	return b.forward.KeysSlice()
+}
+
+// ValuesSlice returns a slice view of the values contained src this map.
+func (b *BidiMap[K, V]) ValuesSlice() []V {
+	return b.forward.ValuesSlice()
+}
+
+// ForEach traverses through all mappings from this map.
+// Use predicate's return boolean value to indicate a break of the iteration.
+// 'K' represents the key whereas 'V' is the value of a map entry.
+func (b *BidiMap[K, V]) ForEach(predicateFunc collection.IterablePredicateBiFunc[K, V]) {
+	b.forward.ForEach(predicateFunc)
+}
@@ -0,0 +1,80 @@
+package maps_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/maps"
+)
+
+func TestBidiMap_PutSafe(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      maps.ValueCollisionPolicy
+		expErr      error
+		expKey      string
+		expOldFound bool
+	}{
+		{
+			name:        "reject",
+			policy:      maps.RejectValueCollision,
+			expErr:      maps.ErrValueAlreadyBound,
+			expKey:      "foo",
+			expOldFound: true,
+		},
+		{
+			name:        "overwrite",
+			policy:      maps.OverwriteValueCollision,
+			expErr:      nil,
+			expKey:      "bar",
+			expOldFound: false,
+		},
+		{
+			name:        "keep",
+			policy:      maps.KeepValueCollision,
+			expErr:      nil,
+			expKey:      "foo",
+			expOldFound: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mp := maps.NewBidiMap[string, int](tt.policy)
+			assert.NoError(t, mp.PutSafe("foo", 1))
+			err := mp.PutSafe("bar", 1)
+			assert.Equal(t, tt.expErr, err)
+			key, _ := mp.GetKey(1)
+			assert.Equal(t, tt.expKey, key)
+			assert.Equal(t, tt.expOldFound, mp.ContainsKey("foo"))
+			assert.Equal(t, 1, mp.Len())
+			assert.Equal(t, 1, mp.Inverse().Len())
+		})
+	}
+}
+
+func TestBidiMap_Inverse(t *testing.T) {
+	mp := maps.NewBidiMap[string, int](maps.RejectValueCollision)
+	mp.Put("foo", 1)
+	mp.Put("bar", 2)
+	inverse := mp.Inverse()
+
+	key, ok := inverse.Get(2)
+	assert.True(t, ok)
+	assert.Equal(t, "bar", key)
+
+	// re-binding a key releases its previous value
+	mp.Put("bar", 3)
+	assert.False(t, inverse.ContainsKey(2))
+	assert.Equal(t, "bar", inverse.GetWithFallback(3, ""))
+
+	inverse.Put(4, "baz")
+	assert.Equal(t, 4, mp.GetWithFallback("baz", 0))
+	assert.False(t, inverse.PutIfAbsent(5, "foo"))
+
+	assert.Equal(t, "foo", mp.RemoveValue(1))
+	assert.False(t, mp.ContainsKey("foo"))
+	assert.Equal(t, 2, inverse.Len())
+	assert.Same(t, mp, inverse.(*maps.BidiMap[int, string]).Inverse())
+}
@@ -0,0 +1,11 @@
+package maps
+
+import "errors"
+
+var (
+	ErrValueAlreadyBound  = errors.New("nolan.maps: value already bound to a different key")
+	ErrImmutable          = errors.New("nolan.maps: persistent maps cannot be mutated in place")
+	ErrTransientPersisted = errors.New("nolan.maps: transient was already made persistent")
+	ErrUnknownTableKey    = errors.New("nolan.maps: key is not part of the table")
+	ErrKeyOutOfRange      = errors.New("nolan.maps: key is out of the view's range")
+)
@@ -0,0 +1,7 @@
+package maps
+
+// ReflectHash exposes the hasher used as PersistentHashMap's default one before Go 1.24, so it is tested regardless
+// of the toolchain.
+func ReflectHash[K comparable](key K) uint64 {
+	return reflectHash(key)
+}
@@ -0,0 +1,12 @@
+//go:build go1.24
+
+package maps
+
+import "hash/maphash"
+
+var hashSeed = maphash.MakeSeed()
+
+// defaultHash hashes key with hash/maphash, seeded once per process.
+func defaultHash[K comparable](key K) uint64 {
+	return maphash.Comparable(hashSeed, key)
+}
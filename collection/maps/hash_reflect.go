@@ -0,0 +1,78 @@
+package maps
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+// reflectHash hashes key with hash/maphash by walking its value through reflection, seeded once per process.
+//
+// Follows the semantics of the == operator: pointers, channels and unsafe pointers are hashed by address, structs
+// and arrays field by field, and interfaces by their dynamic type and value. Positive and negative floating-point
+// zeros hash equally.
+func reflectHash[K comparable](key K) uint64 {
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+	// go through a pointer so interface keys keep their reflect.Interface kind
+	writeReflectHash(&h, reflect.ValueOf(&key).Elem())
+	return h.Sum64()
+}
+
+func writeReflectHash(h *maphash.Hash, v reflect.Value) {
+	var buf [8]byte
+	writeUint := func(u uint64) {
+		binary.LittleEndian.PutUint64(buf[:], u)
+		_, _ = h.Write(buf[:])
+	}
+	writeFloat := func(f float64) {
+		if f == 0 {
+			f = 0 // -0 == 0
+		}
+		writeUint(math.Float64bits(f))
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			_ = h.WriteByte(1)
+		} else {
+			_ = h.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		writeFloat(real(c))
+		writeFloat(imag(c))
+	case reflect.String:
+		writeUint(uint64(v.Len()))
+		_, _ = h.WriteString(v.String())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint(uint64(v.Pointer()))
+	case reflect.Interface:
+		if v.IsNil() {
+			_ = h.WriteByte(0)
+			return
+		}
+		elem := v.Elem()
+		_ = h.WriteByte(1)
+		_, _ = h.WriteString(elem.Type().String())
+		writeReflectHash(h, elem)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			writeReflectHash(h, v.Field(i))
+		}
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			writeReflectHash(h, v.Index(i))
+		}
+	default:
+		panic("hash of unhashable type " + v.Type().String())
+	}
+}
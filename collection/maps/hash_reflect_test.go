@@ -0,0 +1,62 @@
+package maps_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/maps"
+)
+
+type hashKey struct {
+	name  string
+	ptr   *int
+	inner [2]float64
+	extra any
+}
+
+func TestReflectHash(t *testing.T) {
+	a, b := 1, 1
+	assert.Equal(t, maps.ReflectHash(&a), maps.ReflectHash(&a))
+	assert.NotEqual(t, maps.ReflectHash(&a), maps.ReflectHash(&b))
+
+	// pointers are hashed by address, so mutating the pointee keeps the hash
+	hash := maps.ReflectHash(&a)
+	a = 2
+	assert.Equal(t, hash, maps.ReflectHash(&a))
+
+	keyA := hashKey{name: "x", ptr: &a, inner: [2]float64{0, 1}, extra: 3}
+	keyB := hashKey{name: "x", ptr: &a, inner: [2]float64{math.Copysign(0, -1), 1}, extra: 3}
+	assert.Equal(t, keyA, keyB)
+	assert.Equal(t, maps.ReflectHash(keyA), maps.ReflectHash(keyB))
+	keyB.ptr = &b
+	assert.NotEqual(t, maps.ReflectHash(keyA), maps.ReflectHash(keyB))
+
+	ch := make(chan int)
+	assert.Equal(t, maps.ReflectHash(ch), maps.ReflectHash(ch))
+	assert.NotEqual(t, maps.ReflectHash(ch), maps.ReflectHash(make(chan int)))
+
+	assert.Equal(t, maps.ReflectHash[any](7), maps.ReflectHash[any](7))
+	assert.NotEqual(t, maps.ReflectHash[any](7), maps.ReflectHash[any](int64(7)))
+	assert.Equal(t, maps.ReflectHash[any](nil), maps.ReflectHash[any](nil))
+	assert.Panics(t, func() {
+		maps.ReflectHash[any]([]int{1})
+	})
+}
+
+func TestReflectHash_PersistentHashMap(t *testing.T) {
+	a, b := 1, 1
+	mp := maps.NewPersistentHashMapWithHashFunc[*int, string](maps.ReflectHash[*int]).
+		With(&a, "a").
+		With(&b, "b")
+	a = 5
+	val, ok := mp.Get(&a)
+	assert.True(t, ok)
+	assert.Equal(t, "a", val)
+	mp = mp.With(&a, "c")
+	assert.Equal(t, 2, mp.Len())
+	mp = mp.Without(&a)
+	assert.False(t, mp.ContainsKey(&a))
+	assert.True(t, mp.ContainsKey(&b))
+}
@@ -0,0 +1,163 @@
+package maps
+
+import (
+	"github.com/neutrinocorp/nolan/collection"
+)
+
+// HashTable is the Go's map implementation of Table, keeping a map of columns per row. Rows left without mappings
+// are removed.
+//
+// Put, Get, Remove, ContainsRow and ContainsColumn take O(1) time. Row views are traversed in O(n) time, n being the
+// number of columns in the row, while Column views are traversed in O(m) time, m being the number of rows.
+// Iteration order is unspecified.
+//
+// Zero-value is ready to use.
+type HashTable[R, C comparable, V any] struct {
+	rows map[R]map[C]V
+	// columnCounts holds the number of mappings of each column, so ContainsColumn takes O(1) time.
+	columnCounts map[C]int
+	len          int
+}
+
+var _ Table[string, string, int] = &HashTable[string, string, int]{}
+
+// NewHashTable allocates a new HashTable instance.
+func NewHashTable[R, C comparable, V any]() *HashTable[R, C, V] {
+	return &HashTable[R, C, V]{
+		rows:         make(map[R]map[C]V),
+		columnCounts: make(map[C]int),
+	}
+}
+
+// Put associates the specified value with the specified row and column keys in this table.
+func (t *HashTable[R, C, V]) Put(row R, col C, val V) {
+	if t.rows == nil {
+		t.rows = make(map[R]map[C]V)
+		t.columnCounts = make(map[C]int)
+	}
+	columns, ok := t.rows[row]
+	if !ok {
+		columns = make(map[C]V)
+		t.rows[row] = columns
+	}
+	if _, ok = columns[col]; !ok {
+		t.columnCounts[col]++
+		t.len++
+	}
+	columns[col] = val
+}
+
+// Get returns the value to which the specified row and column keys are mapped.
+func (t *HashTable[R, C, V]) Get(row R, col C) (V, bool) {
+	val, ok := t.rows[row][col]
+	return val, ok
+}
+
+// Remove removes the mapping for the specified row and column keys from this table if it is present, returning its
+// value.
+func (t *HashTable[R, C, V]) Remove(row R, col C) V {
+	columns := t.rows[row]
+	val, ok := columns[col]
+	if !ok {
+		return val
+	}
+	delete(columns, col)
+	if len(columns) == 0 {
+		delete(t.rows, row)
+	}
+	if t.columnCounts[col]--; t.columnCounts[col] == 0 {
+		delete(t.columnCounts, col)
+	}
+	t.len--
+	return val
+}
+
+// Contains returns true if this table contains a mapping for the specified row and column keys.
+func (t *HashTable[R, C, V]) Contains(row R, col C) bool {
+	_, ok := t.rows[row][col]
+	return ok
+}
+
+// ContainsRow returns true if this table contains a mapping with the specified row key.
+func (t *HashTable[R, C, V]) ContainsRow(row R) bool {
+	_, ok := t.rows[row]
+	return ok
+}
+
+// ContainsColumn returns true if this table contains a mapping with the specified column key.
+func (t *HashTable[R, C, V]) ContainsColumn(col C) bool {
+	_, ok := t.columnCounts[col]
+	return ok
+}
+
+// Row returns a live Map view of the mappings with the specified row key, keyed by column.
+func (t *HashTable[R, C, V]) Row(row R) Map[C, V] {
+	return tableLineView[C, V]{
+		getFunc: func(col C) (V, bool) {
+			return t.Get(row, col)
+		},
+		putFunc: func(col C, val V) {
+			t.Put(row, col, val)
+		},
+		removeFunc: func(col C) V {
+			return t.Remove(row, col)
+		},
+		forEachFunc: func(predicateFunc collection.IterablePredicateBiFunc[C, V]) {
+			for col, val := range t.rows[row] {
+				if predicateFunc(col, val) {
+					break
+				}
+			}
+		},
+	}
+}
+
+// Column returns a live Map view of the mappings with the specified column key, keyed by row.
+func (t *HashTable[R, C, V]) Column(col C) Map[R, V] {
+	return tableLineView[R, V]{
+		getFunc: func(row R) (V, bool) {
+			return t.Get(row, col)
+		},
+		putFunc: func(row R, val V) {
+			t.Put(row, col, val)
+		},
+		removeFunc: func(row R) V {
+			return t.Remove(row, col)
+		},
+		forEachFunc: func(predicateFunc collection.IterablePredicateBiFunc[R, V]) {
+			for row, columns := range t.rows {
+				if val, ok := columns[col]; ok && predicateFunc(row, val) {
+					break
+				}
+			}
+		},
+	}
+}
+
+// CellSet returns a slice copy of all the cells contained in this table.
+func (t *HashTable[R, C, V]) CellSet() []Cell[R, C, V] {
+	buf := make([]Cell[R, C, V], 0, t.len)
+	for row, columns := range t.rows {
+		for col, val := range columns {
+			buf = append(buf, Cell[R, C, V]{Row: row, Column: col, Value: val})
+		}
+	}
+	return buf
+}
+
+// Len returns the number of cells in this table.
+func (t *HashTable[R, C, V]) Len() int {
+	return t.len
+}
+
+// IsEmpty returns true if this table contains no cells.
+func (t *HashTable[R, C, V]) IsEmpty() bool {
+	return t.len == 0
+}
+
+// Clear removes all the cells from this table.
+func (t *HashTable[R, C, V]) Clear() {
+	clear(t.rows)
+	clear(t.columnCounts)
+	t.len = 0
+}
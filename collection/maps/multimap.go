@@ -0,0 +1,331 @@
+package maps
+
+import (
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/collection/set"
+	"github.com/neutrinocorp/nolan/function"
+)
+
+// Multimap A structure that maps keys to values, similar to Map, but in which each key may be associated with
+// multiple values. Values associated with a key are kept in a collection.Collection, also known as its group.
+type Multimap[K, V comparable] interface {
+	// Put Associates the specified value with the specified key in this multimap. Returns TRUE if the multimap was
+	// modified.
+	Put(key K, val V) bool
+	// PutAll Associates all the values in the specified collection with the specified key in this multimap.
+	PutAll(key K, values collection.Collection[V]) bool
+	// PutSlice Associates all the values in the specified slice (variadic) with the specified key in this multimap.
+	PutSlice(key K, values ...V) bool
+	// Get Returns a live collection.Collection view of the values associated with the specified key. Changes to the
+	// view (e.g., Add, Clear) are reflected in this multimap and vice versa, even if the key is not present yet.
+	Get(key K) collection.Collection[V]
+	// Remove Removes a single key-value pair with the specified key and value from this multimap, if present.
+	Remove(key K, val V) bool
+	// RemoveAll Removes all the values associated with the specified key, returning them.
+	RemoveAll(key K) collection.Collection[V]
+	// ContainsKey Returns true if this multimap contains at least one key-value pair with the specified key.
+	ContainsKey(key K) bool
+	// ContainsEntry Returns true if this multimap contains a key-value pair with the specified key and value.
+	ContainsEntry(key K, val V) bool
+	// Len Returns the number of key-value pairs in this multimap.
+	Len() int
+	// IsEmpty Returns true if this multimap contains no key-value pairs.
+	IsEmpty() bool
+	// Clear Removes all the key-value pairs from this multimap.
+	Clear()
+	// Entries Returns a slice copy of all the key-value pairs contained in this multimap.
+	Entries() []Entry[K, V]
+	// KeySet Returns a set.Set copy of the distinct keys contained in this multimap.
+	KeySet() set.Set[K]
+	// ForEach traverses through all the key-value pairs from this multimap. Use predicate's return boolean value to
+	// indicate a break of the iteration. 'A' represents the key whereas 'B' is the value of a pair.
+	ForEach(predicateFunc collection.IterablePredicateBiFunc[K, V])
+}
+
+// groupedMultimap is the implementation of Multimap shared by ListMultimap and SetMultimap. It keeps a group per key
+// in a LinkedHashMap, so keys are traversed in insertion order. Empty groups are removed.
+type groupedMultimap[K, V comparable] struct {
+	groups       *LinkedHashMap[K, collection.Collection[V]]
+	newGroupFunc func() collection.Collection[V]
+	len          int
+}
+
+func newGroupedMultimap[K, V comparable](newGroupFunc func() collection.Collection[V]) groupedMultimap[K, V] {
+	return groupedMultimap[K, V]{
+		groups:       NewLinkedHashMap[K, collection.Collection[V]](),
+		newGroupFunc: newGroupFunc,
+	}
+}
+
+// Put associates the specified value with the specified key in this multimap. Returns TRUE if the multimap was
+// modified.
+func (m *groupedMultimap[K, V]) Put(key K, val V) bool {
+	group, ok := m.groups.Get(key)
+	if !ok {
+		group = m.newGroupFunc()
+	}
+	if !group.Add(val) {
+		return false
+	}
+	if !ok {
+		m.groups.Put(key, group)
+	}
+	m.len++
+	return true
+}
+
+// PutAll associates all the values in the specified collection with the specified key in this multimap.
+func (m *groupedMultimap[K, V]) PutAll(key K, values collection.Collection[V]) bool {
+	wasMod := false
+	values.ForEach(func(val V) bool {
+		if m.Put(key, val) {
+			wasMod = true
+		}
+		return false
+	})
+	return wasMod
+}
+
+// PutSlice associates all the values in the specified slice (variadic) with the specified key in this multimap.
+func (m *groupedMultimap[K, V]) PutSlice(key K, values ...V) bool {
+	wasMod := false
+	for _, val := range values {
+		if m.Put(key, val) {
+			wasMod = true
+		}
+	}
+	return wasMod
+}
+
+// Get returns a live collection.Collection view of the values associated with the specified key.
+func (m *groupedMultimap[K, V]) Get(key K) collection.Collection[V] {
+	return &multimapView[K, V]{
+		source: m,
+		key:    key,
+	}
+}
+
+// Remove removes a single key-value pair with the specified key and value from this multimap, if present.
+func (m *groupedMultimap[K, V]) Remove(key K, val V) bool {
+	group, ok := m.groups.Get(key)
+	if !ok || !collection.Remove(group, val) {
+		return false
+	}
+	m.len--
+	if group.IsEmpty() {
+		m.groups.Remove(key)
+	}
+	return true
+}
+
+// RemoveAll removes all the values associated with the specified key, returning them.
+func (m *groupedMultimap[K, V]) RemoveAll(key K) collection.Collection[V] {
+	group, ok := m.groups.Get(key)
+	if !ok {
+		return m.newGroupFunc()
+	}
+	m.groups.Remove(key)
+	m.len -= group.Len()
+	return group
+}
+
+func (m *groupedMultimap[K, V]) removeIf(key K, predicateFunc function.PredicateFunc[V]) bool {
+	group, ok := m.groups.Get(key)
+	if !ok {
+		return false
+	}
+	prevLen := group.Len()
+	if !collection.RemoveIf(group, predicateFunc) {
+		return false
+	}
+	m.len -= prevLen - group.Len()
+	if group.IsEmpty() {
+		m.groups.Remove(key)
+	}
+	return true
+}
+
+// ContainsKey returns true if this multimap contains at least one key-value pair with the specified key.
+func (m *groupedMultimap[K, V]) ContainsKey(key K) bool {
+	return m.groups.ContainsKey(key)
+}
+
+// ContainsEntry returns true if this multimap contains a key-value pair with the specified key and value.
+func (m *groupedMultimap[K, V]) ContainsEntry(key K, val V) bool {
+	group, ok := m.groups.Get(key)
+	if !ok {
+		return false
+	} else if comparableGroup, isComparable := group.(collection.ComparableCollection[V]); isComparable {
+		return comparableGroup.Contains(val)
+	}
+	return collection.Contains(group, val)
+}
+
+// Len returns the number of key-value pairs in this multimap.
+func (m *groupedMultimap[K, V]) Len() int {
+	return m.len
+}
+
+// IsEmpty returns true if this multimap contains no key-value pairs.
+func (m *groupedMultimap[K, V]) IsEmpty() bool {
+	return m.len == 0
+}
+
+// Clear removes all the key-value pairs from this multimap.
+func (m *groupedMultimap[K, V]) Clear() {
+	m.groups.Clear()
+	m.len = 0
+}
+
+// Entries returns a slice copy of all the key-value pairs contained in this multimap.
+func (m *groupedMultimap[K, V]) Entries() []Entry[K, V] {
+	buf := make([]Entry[K, V], 0, m.len)
+	m.ForEach(func(key K, val V) bool {
+		buf = append(buf, Entry[K, V]{Key: key, Value: val})
+		return false
+	})
+	return buf
+}
+
+// KeySet returns a set.LinkedHashSet copy of the distinct keys contained in this multimap, in insertion order.
+func (m *groupedMultimap[K, V]) KeySet() set.Set[K] {
+	return set.NewLinkedHashSetFromSlice(m.groups.KeysSlice())
+}
+
+// ForEach traverses through all the key-value pairs from this multimap, grouped by key in insertion order.
+// Use predicate's return boolean value to indicate a break of the iteration.
+// 'K' represents the key whereas 'V' is the value of a pair.
+func (m *groupedMultimap[K, V]) ForEach(predicateFunc collection.IterablePredicateBiFunc[K, V]) {
+	m.groups.ForEach(func(key K, group collection.Collection[V]) bool {
+		isBreak := false
+		group.ForEach(func(val V) bool {
+			isBreak = predicateFunc(key, val)
+			return isBreak
+		})
+		return isBreak
+	})
+}
+
+// ListMultimap is the list.SliceList implementation of Multimap. A key may be associated with the same value several
+// times, and values are kept in insertion order.
+type ListMultimap[K, V comparable] struct {
+	groupedMultimap[K, V]
+}
+
+var _ Multimap[string, int] = &ListMultimap[string, int]{}
+
+// NewListMultimap allocates a new ListMultimap instance.
+func NewListMultimap[K, V comparable]() *ListMultimap[K, V] {
+	return &ListMultimap[K, V]{
+		groupedMultimap: newGroupedMultimap[K, V](func() collection.Collection[V] {
+			return list.NewSliceList[V](nil)
+		}),
+	}
+}
+
+// SetMultimap is the set.LinkedHashSet implementation of Multimap. A key cannot be associated with the same value
+// more than once (i.e., Put returns FALSE), and values are kept in insertion order.
+type SetMultimap[K, V comparable] struct {
+	groupedMultimap[K, V]
+}
+
+var _ Multimap[string, int] = &SetMultimap[string, int]{}
+
+// NewSetMultimap allocates a new SetMultimap instance.
+func NewSetMultimap[K, V comparable]() *SetMultimap[K, V] {
+	return &SetMultimap[K, V]{
+		groupedMultimap: newGroupedMultimap[K, V](func() collection.Collection[V] {
+			return set.NewLinkedHashSet[V]()
+		}),
+	}
+}
+
+// IndexBy returns a new ListMultimap grouping the elements of src by the key returned by keyFunc, in iteration order.
+//
+// e.g. IndexBy(users, func(u User) string { return u.Country }) returns the users of every country.
+func IndexBy[K, V comparable](src collection.Collection[V], keyFunc function.DelegateFunc[V, K]) *ListMultimap[K, V] {
+	buf := NewListMultimap[K, V]()
+	src.ForEach(func(val V) bool {
+		buf.Put(keyFunc(val), val)
+		return false
+	})
+	return buf
+}
+
+// InvertMultimap copies every key-value pair of src into dst with reversed keys and values, returning dst.
+func InvertMultimap[K, V comparable](src Multimap[K, V], dst Multimap[V, K]) Multimap[V, K] {
+	src.ForEach(func(key K, val V) bool {
+		dst.Put(val, key)
+		return false
+	})
+	return dst
+}
+
+// multimapView is the live collection.Collection view of a key's group returned by Multimap.Get. Every routine
+// looks the group up, so the view keeps working after the group is removed or created.
+type multimapView[K, V comparable] struct {
+	source *groupedMultimap[K, V]
+	key    K
+}
+
+var _ collection.RemovableCollection[int] = &multimapView[string, int]{}
+
+func (v *multimapView[K, V]) group() collection.Collection[V] {
+	group, ok := v.source.groups.Get(v.key)
+	if !ok {
+		return v.source.newGroupFunc()
+	}
+	return group
+}
+
+// NewIterator returns an iterator over the values of this group.
+func (v *multimapView[K, V]) NewIterator() collection.Iterator[V] {
+	return v.group().NewIterator()
+}
+
+// Add associates v with the key of this group.
+func (v *multimapView[K, V]) Add(val V) bool {
+	return v.source.Put(v.key, val)
+}
+
+// AddAll associates all the values in the specified collection with the key of this group.
+func (v *multimapView[K, V]) AddAll(src collection.Collection[V]) bool {
+	return v.source.PutAll(v.key, src)
+}
+
+// AddSlice associates all the values in the specified slice (variadic) with the key of this group.
+func (v *multimapView[K, V]) AddSlice(items ...V) bool {
+	return v.source.PutSlice(v.key, items...)
+}
+
+// RemoveIf removes all the values matching predicateFunc from this group.
+func (v *multimapView[K, V]) RemoveIf(predicateFunc function.PredicateFunc[V]) bool {
+	return v.source.removeIf(v.key, predicateFunc)
+}
+
+// Clear removes all the values of this group.
+func (v *multimapView[K, V]) Clear() {
+	v.source.RemoveAll(v.key)
+}
+
+// Len returns the number of values in this group.
+func (v *multimapView[K, V]) Len() int {
+	return v.group().Len()
+}
+
+// IsEmpty returns true if this group contains no values.
+func (v *multimapView[K, V]) IsEmpty() bool {
+	return !v.source.groups.ContainsKey(v.key)
+}
+
+// ToSlice returns all the values from this group as a slice of V.
+func (v *multimapView[K, V]) ToSlice() []V {
+	return v.group().ToSlice()
+}
+
+// ForEach traverses through all the values from this group.
+// Use predicate's return value to indicate a break of the iteration, TRUE meaning a break.
+func (v *multimapView[K, V]) ForEach(predicateFunc collection.IterablePredicateFunc[V]) {
+	v.group().ForEach(predicateFunc)
+}
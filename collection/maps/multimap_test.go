@@ -0,0 +1,100 @@
+package maps_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/collection/maps"
+)
+
+func TestMultimap(t *testing.T) {
+	tests := []struct {
+		name        string
+		mm          maps.Multimap[string, int]
+		expFoo      []int
+		expLen      int
+		expRemoveAt []int
+	}{
+		{
+			name:        "list",
+			mm:          maps.NewListMultimap[string, int](),
+			expFoo:      []int{1, 2, 1},
+			expLen:      4,
+			expRemoveAt: []int{2, 1},
+		},
+		{
+			name:        "set",
+			mm:          maps.NewSetMultimap[string, int](),
+			expFoo:      []int{1, 2},
+			expLen:      3,
+			expRemoveAt: []int{2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mm := tt.mm
+			assert.True(t, mm.Put("foo", 1))
+			mm.PutSlice("foo", 2, 1)
+			mm.PutAll("bar", list.NewSliceList([]int{3}))
+			assert.Equal(t, tt.expFoo, mm.Get("foo").ToSlice())
+			assert.Equal(t, tt.expLen, mm.Len())
+			assert.True(t, mm.ContainsEntry("foo", 2))
+			assert.False(t, mm.ContainsEntry("bar", 2))
+			assert.Equal(t, []string{"foo", "bar"}, mm.KeySet().ToSlice())
+			assert.Equal(t, maps.Entry[string, int]{Key: "bar", Value: 3}, mm.Entries()[len(tt.expFoo)])
+
+			assert.True(t, mm.Remove("foo", 1))
+			assert.False(t, mm.Remove("baz", 1))
+			assert.Equal(t, tt.expRemoveAt, mm.Get("foo").ToSlice())
+			assert.Equal(t, []int{3}, mm.RemoveAll("bar").ToSlice())
+			assert.False(t, mm.ContainsKey("bar"))
+			assert.Equal(t, len(tt.expRemoveAt), mm.Len())
+			assert.True(t, mm.RemoveAll("bar").IsEmpty())
+
+			mm.Clear()
+			assert.True(t, mm.IsEmpty())
+			assert.Empty(t, mm.Entries())
+		})
+	}
+}
+
+func TestMultimap_LiveView(t *testing.T) {
+	mm := maps.NewListMultimap[string, int]()
+	view := mm.Get("foo")
+	assert.True(t, view.IsEmpty())
+
+	view.AddSlice(1, 2, 3, 4)
+	assert.Equal(t, 4, mm.Len())
+	assert.True(t, mm.ContainsEntry("foo", 3))
+
+	mm.Put("foo", 5)
+	assert.Equal(t, 5, view.Len())
+	assert.True(t, collection.RemoveIf(view, func(v int) bool {
+		return v%2 == 0
+	}))
+	assert.Equal(t, []int{1, 3, 5}, mm.Get("foo").ToSlice())
+	assert.Equal(t, 3, mm.Len())
+
+	view.Clear()
+	assert.False(t, mm.ContainsKey("foo"))
+	assert.Equal(t, 0, mm.Len())
+	view.Add(6)
+	assert.Equal(t, []int{6}, mm.Get("foo").ToSlice())
+}
+
+func TestIndexByAndInvert(t *testing.T) {
+	words := list.NewSliceList([]string{"apple", "avocado", "banana", "blueberry", "cherry"})
+	byInitial := maps.IndexBy[string, string](words, func(word string) string {
+		return strings.ToUpper(word[:1])
+	})
+	assert.Equal(t, []string{"A", "B", "C"}, byInitial.KeySet().ToSlice())
+	assert.Equal(t, []string{"banana", "blueberry"}, byInitial.Get("B").ToSlice())
+
+	inverse := maps.InvertMultimap[string, string](byInitial, maps.NewSetMultimap[string, string]())
+	assert.Equal(t, 5, inverse.Len())
+	assert.True(t, inverse.ContainsEntry("cherry", "C"))
+}
@@ -0,0 +1,455 @@
+package maps
+
+import (
+	"math/bits"
+
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/function"
+)
+
+// HashFunc is a function.DelegateFunc type used to hash the keys of a PersistentHashMap. Keys which are equal must
+// share the same hash.
+type HashFunc[K comparable] function.DelegateFunc[K, uint64]
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+	// hamtMaxShift is the shift past which hashes are exhausted and colliding keys share a collision node.
+	hamtMaxShift = 64
+)
+
+// hamtEdit identifies the TransientHashMap owning a node, which may then be mutated in place.
+// It is not zero-sized, so every allocation yields a distinct pointer.
+type hamtEdit struct {
+	_ byte
+}
+
+// hamtSlot is either a key-value mapping or, if child is not nil, a sub-node.
+type hamtSlot[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	val   V
+	child *hamtNode[K, V]
+}
+
+// hamtNode is a node of the PersistentHashMap trie. Bitmap nodes keep a slot for each set bit of bitmap, in bit
+// order, while collision nodes (i.e., nodes placed at hamtMaxShift) keep every mapping sharing a hash, unordered.
+type hamtNode[K comparable, V any] struct {
+	edit   *hamtEdit
+	bitmap uint32
+	slots  []hamtSlot[K, V]
+}
+
+// editable returns n if it is owned by edit, or a copy of n owned by edit otherwise.
+// A nil edit (i.e., persistent operations) always copies the node.
+func (n *hamtNode[K, V]) editable(edit *hamtEdit) *hamtNode[K, V] {
+	if edit != nil && n.edit == edit {
+		return n
+	}
+	return &hamtNode[K, V]{
+		edit:   edit,
+		bitmap: n.bitmap,
+		slots:  append(make([]hamtSlot[K, V], 0, len(n.slots)+1), n.slots...),
+	}
+}
+
+func hamtBit(hash uint64, shift uint) uint32 {
+	return 1 << ((hash >> shift) & hamtMask)
+}
+
+func (n *hamtNode[K, V]) index(bit uint32) int {
+	return bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *hamtNode[K, V]) get(hash uint64, key K) (V, bool) {
+	node := n
+	for shift := uint(0); node != nil; shift += hamtBits {
+		if shift >= hamtMaxShift {
+			for _, slot := range node.slots {
+				if slot.key == key {
+					return slot.val, true
+				}
+			}
+			break
+		}
+		bit := hamtBit(hash, shift)
+		if node.bitmap&bit == 0 {
+			break
+		}
+		slot := node.slots[node.index(bit)]
+		if slot.child == nil {
+			if slot.hash == hash && slot.key == key {
+				return slot.val, true
+			}
+			break
+		}
+		node = slot.child
+	}
+	var zeroVal V
+	return zeroVal, false
+}
+
+// put associates entry's key with its value within the subtree of n, returning the new subtree and whether a new
+// mapping was added (instead of replacing an existing one).
+func (n *hamtNode[K, V]) put(shift uint, entry hamtSlot[K, V], edit *hamtEdit) (*hamtNode[K, V], bool) {
+	if n == nil {
+		return newHamtNode(shift, entry, edit), true
+	} else if shift >= hamtMaxShift {
+		node := n.editable(edit)
+		for i := range node.slots {
+			if node.slots[i].key == entry.key {
+				node.slots[i].val = entry.val
+				return node, false
+			}
+		}
+		node.slots = append(node.slots, entry)
+		return node, true
+	}
+
+	bit := hamtBit(entry.hash, shift)
+	index := n.index(bit)
+	node := n.editable(edit)
+	if n.bitmap&bit == 0 {
+		node.bitmap |= bit
+		node.slots = append(node.slots, hamtSlot[K, V]{})
+		copy(node.slots[index+1:], node.slots[index:])
+		node.slots[index] = entry
+		return node, true
+	}
+
+	slot := &node.slots[index]
+	switch {
+	case slot.child != nil:
+		child, isAdded := slot.child.put(shift+hamtBits, entry, edit)
+		slot.child = child
+		return node, isAdded
+	case slot.hash == entry.hash && slot.key == entry.key:
+		slot.val = entry.val
+		return node, false
+	}
+	child := newHamtNode(shift+hamtBits, *slot, edit)
+	child, _ = child.put(shift+hamtBits, entry, edit)
+	*slot = hamtSlot[K, V]{child: child}
+	return node, true
+}
+
+// remove removes the mapping for key within the subtree of n, returning the new subtree (nil if it became empty)
+// and whether a mapping was removed. Sub-nodes left with a single mapping are collapsed into their parent.
+func (n *hamtNode[K, V]) remove(shift uint, hash uint64, key K, edit *hamtEdit) (*hamtNode[K, V], bool) {
+	if shift >= hamtMaxShift {
+		for i := range n.slots {
+			if n.slots[i].key == key {
+				return n.removeSlot(i, 0, edit), true
+			}
+		}
+		return n, false
+	}
+
+	bit := hamtBit(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	index := n.index(bit)
+	slot := n.slots[index]
+	if slot.child == nil {
+		if slot.hash != hash || slot.key != key {
+			return n, false
+		}
+		return n.removeSlot(index, bit, edit), true
+	}
+
+	child, isRemoved := slot.child.remove(shift+hamtBits, hash, key, edit)
+	if !isRemoved {
+		return n, false
+	} else if child == nil {
+		return n.removeSlot(index, bit, edit), true
+	}
+	node := n.editable(edit)
+	if len(child.slots) == 1 && child.slots[0].child == nil {
+		node.slots[index] = child.slots[0]
+	} else {
+		node.slots[index].child = child
+	}
+	return node, true
+}
+
+func (n *hamtNode[K, V]) removeSlot(index int, bit uint32, edit *hamtEdit) *hamtNode[K, V] {
+	if len(n.slots) == 1 {
+		return nil
+	}
+	node := n.editable(edit)
+	node.bitmap &^= bit
+	copy(node.slots[index:], node.slots[index+1:])
+	node.slots[len(node.slots)-1] = hamtSlot[K, V]{}
+	node.slots = node.slots[:len(node.slots)-1]
+	return node
+}
+
+// forEach traverses through every mapping under n. Returns true if predicateFunc asked to break the iteration.
+func (n *hamtNode[K, V]) forEach(predicateFunc collection.IterablePredicateBiFunc[K, V]) bool {
+	for _, slot := range n.slots {
+		if slot.child != nil {
+			if slot.child.forEach(predicateFunc) {
+				return true
+			}
+		} else if predicateFunc(slot.key, slot.val) {
+			return true
+		}
+	}
+	return false
+}
+
+func newHamtNode[K comparable, V any](shift uint, entry hamtSlot[K, V], edit *hamtEdit) *hamtNode[K, V] {
+	node := &hamtNode[K, V]{
+		edit:  edit,
+		slots: []hamtSlot[K, V]{entry},
+	}
+	if shift < hamtMaxShift {
+		node.bitmap = hamtBit(entry.hash, shift)
+	}
+	return node
+}
+
+// hamt is the structure shared by PersistentHashMap and TransientHashMap.
+type hamt[K comparable, V any] struct {
+	root     *hamtNode[K, V]
+	len      int
+	hashFunc HashFunc[K]
+}
+
+func (h *hamt[K, V]) hash(key K) uint64 {
+	if h.hashFunc == nil {
+		return defaultHash(key)
+	}
+	return h.hashFunc(key)
+}
+
+func (h *hamt[K, V]) get(key K) (V, bool) {
+	return h.root.get(h.hash(key), key)
+}
+
+func (h *hamt[K, V]) put(key K, val V, edit *hamtEdit) {
+	var isAdded bool
+	h.root, isAdded = h.root.put(0, hamtSlot[K, V]{hash: h.hash(key), key: key, val: val}, edit)
+	if isAdded {
+		h.len++
+	}
+}
+
+func (h *hamt[K, V]) remove(key K, edit *hamtEdit) bool {
+	if h.root == nil {
+		return false
+	}
+	var isRemoved bool
+	h.root, isRemoved = h.root.remove(0, h.hash(key), key, edit)
+	if isRemoved {
+		h.len--
+	}
+	return isRemoved
+}
+
+// PersistentHashMap is the immutable, hash array mapped trie (HAMT) implementation of Map. Every version of a map is
+// safe to share across goroutines: With and Without return new versions sharing most of their structure with the
+// original one, which is left untouched. Thus, taking a snapshot of a PersistentHashMap is free.
+//
+// PersistentHashMap satisfies Map so it can be passed to read-only routines. However, every mutating Map routine
+// (i.e., Put, PutIfAbsent, PutAll, PutAllEntries, Remove, Replace and Clear) panics with ErrImmutable.
+//
+// Get, With and Without take O(log32 n) time, which is effectively constant. Use a TransientHashMap for bulk loads.
+// Iteration order is unspecified.
+//
+// Zero-value is an empty map hashing keys with hash/maphash.
+type PersistentHashMap[K comparable, V any] struct {
+	hamt hamt[K, V]
+}
+
+var _ Map[string, int] = &PersistentHashMap[string, int]{}
+
+// NewPersistentHashMap allocates a new empty PersistentHashMap instance hashing keys with hash/maphash.
+func NewPersistentHashMap[K comparable, V any]() *PersistentHashMap[K, V] {
+	return &PersistentHashMap[K, V]{}
+}
+
+// NewPersistentHashMapWithHashFunc allocates a new empty PersistentHashMap instance hashing keys with hashFunc.
+// Versions derived from the map keep using hashFunc.
+func NewPersistentHashMapWithHashFunc[K comparable, V any](hashFunc HashFunc[K]) *PersistentHashMap[K, V] {
+	if hashFunc == nil {
+		panic("hashFunc cannot be nil")
+	}
+	return &PersistentHashMap[K, V]{
+		hamt: hamt[K, V]{hashFunc: hashFunc},
+	}
+}
+
+// With returns a new version of this map associating val with key.
+func (m *PersistentHashMap[K, V]) With(key K, val V) *PersistentHashMap[K, V] {
+	h := m.hamt
+	h.put(key, val, nil)
+	return &PersistentHashMap[K, V]{hamt: h}
+}
+
+// Without returns a new version of this map without the mapping for key. Returns this map if key is not present.
+func (m *PersistentHashMap[K, V]) Without(key K) *PersistentHashMap[K, V] {
+	h := m.hamt
+	if !h.remove(key, nil) {
+		return m
+	}
+	return &PersistentHashMap[K, V]{hamt: h}
+}
+
+// Transient returns a TransientHashMap holding the mappings of this map, which is left untouched.
+func (m *PersistentHashMap[K, V]) Transient() *TransientHashMap[K, V] {
+	return &TransientHashMap[K, V]{
+		hamt: m.hamt,
+		edit: &hamtEdit{},
+	}
+}
+
+// Get returns the value to which the specified key is mapped, or null if this map contains no mapping for the key.
+func (m *PersistentHashMap[K, V]) Get(key K) (V, bool) {
+	return m.hamt.get(key)
+}
+
+// GetWithFallback returns the value to which the specified key is mapped, or fallbackValue if this map contains
+// no mapping for the key.
+func (m *PersistentHashMap[K, V]) GetWithFallback(key K, fallbackValue V) V {
+	if val, ok := m.hamt.get(key); ok {
+		return val
+	}
+	return fallbackValue
+}
+
+// Put panics with ErrImmutable; use With instead.
+func (m *PersistentHashMap[K, V]) Put(_ K, _ V) {
+	panic(ErrImmutable)
+}
+
+// PutIfAbsent panics with ErrImmutable; use With instead.
+func (m *PersistentHashMap[K, V]) PutIfAbsent(_ K, _ V) bool {
+	panic(ErrImmutable)
+}
+
+// PutAll panics with ErrImmutable; use a TransientHashMap instead.
+func (m *PersistentHashMap[K, V]) PutAll(_ Map[K, V]) {
+	panic(ErrImmutable)
+}
+
+// PutAllEntries panics with ErrImmutable; use a TransientHashMap instead.
+func (m *PersistentHashMap[K, V]) PutAllEntries(_ ...Entry[K, V]) {
+	panic(ErrImmutable)
+}
+
+// Remove panics with ErrImmutable; use Without instead.
+func (m *PersistentHashMap[K, V]) Remove(_ K) V {
+	panic(ErrImmutable)
+}
+
+// Replace panics with ErrImmutable; use With instead.
+func (m *PersistentHashMap[K, V]) Replace(_ K, _ V) bool {
+	panic(ErrImmutable)
+}
+
+// ContainsKey returns true if this map contains a mapping for the specified key.
+func (m *PersistentHashMap[K, V]) ContainsKey(key K) bool {
+	_, ok := m.hamt.get(key)
+	return ok
+}
+
+// Len returns the number of key-value mappings src this map.
+func (m *PersistentHashMap[K, V]) Len() int {
+	return m.hamt.len
+}
+
+// Clear panics with ErrImmutable; use the zero-value instead.
+func (m *PersistentHashMap[K, V]) Clear() {
+	panic(ErrImmutable)
+}
+
+// Keys returns a collection.Collection copy of the keys contained src this map.
+func (m *PersistentHashMap[K, V]) Keys() collection.Collection[K] {
+	return list.NewSliceList(m.KeysSlice())
+}
+
+// Values returns a collection.Collection copy of the values contained src this map.
+func (m *PersistentHashMap[K, V]) Values() collection.Collection[V] {
+	return list.NewSliceList(m.ValuesSlice())
+}
+
+// KeysSlice returns a slice copy of the keys contained src this map.
+func (m *PersistentHashMap[K, V]) KeysSlice() []K {
+	buf := make([]K, 0, m.hamt.len)
+	m.ForEach(func(key K, _ V) bool {
+		buf = append(buf, key)
+		return false
+	})
+	return buf
+}
+
+// ValuesSlice returns a slice copy of the values contained src this map.
+func (m *PersistentHashMap[K, V]) ValuesSlice() []V {
+	buf := make([]V, 0, m.hamt.len)
+	m.ForEach(func(_ K, val V) bool {
+		buf = append(buf, val)
+		return false
+	})
+	return buf
+}
+
+// ForEach traverses through all mappings from this map. Use predicate's return boolean value to indicate
+// a break of the iteration. 'K' represents the key whereas 'V' is the value of a map entry.
+func (m *PersistentHashMap[K, V]) ForEach(predicateFunc collection.IterablePredicateBiFunc[K, V]) {
+	if m.hamt.root != nil {
+		m.hamt.root.forEach(predicateFunc)
+	}
+}
+
+// TransientHashMap is a mutable builder of PersistentHashMap instances. It mutates in place the nodes it owns, only
+// copying the ones shared with persistent maps, which makes bulk loads considerably cheaper than successive
+// PersistentHashMap.With calls.
+//
+// A TransientHashMap is not safe for concurrent use, and it must not be used once Persistent was called.
+type TransientHashMap[K comparable, V any] struct {
+	hamt hamt[K, V]
+	edit *hamtEdit
+}
+
+func (t *TransientHashMap[K, V]) ensureEditable() {
+	if t.edit == nil {
+		panic(ErrTransientPersisted)
+	}
+}
+
+// Put associates val with key in this map.
+func (t *TransientHashMap[K, V]) Put(key K, val V) *TransientHashMap[K, V] {
+	t.ensureEditable()
+	t.hamt.put(key, val, t.edit)
+	return t
+}
+
+// Remove removes the mapping for key from this map if it is present.
+func (t *TransientHashMap[K, V]) Remove(key K) *TransientHashMap[K, V] {
+	t.ensureEditable()
+	t.hamt.remove(key, t.edit)
+	return t
+}
+
+// Get returns the value to which the specified key is mapped.
+func (t *TransientHashMap[K, V]) Get(key K) (V, bool) {
+	t.ensureEditable()
+	return t.hamt.get(key)
+}
+
+// Len returns the number of key-value mappings in this map.
+func (t *TransientHashMap[K, V]) Len() int {
+	t.ensureEditable()
+	return t.hamt.len
+}
+
+// Persistent returns a PersistentHashMap holding the mappings of this map in O(1) time. This transient cannot be
+// used afterward.
+func (t *TransientHashMap[K, V]) Persistent() *PersistentHashMap[K, V] {
+	t.ensureEditable()
+	t.edit = nil
+	return &PersistentHashMap[K, V]{hamt: t.hamt}
+}
@@ -0,0 +1,113 @@
+package maps_test
+
+import (
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/maps"
+)
+
+func TestPersistentHashMap(t *testing.T) {
+	const n = 5000
+	mp := maps.NewPersistentHashMap[string, int]()
+	for i := 0; i < n; i++ {
+		mp = mp.With(strconv.Itoa(i), i)
+	}
+	snapshot := mp
+	mp = mp.With("0", -1)
+	for i := 0; i < n; i += 2 {
+		mp = mp.Without(strconv.Itoa(i))
+	}
+
+	assert.Equal(t, n, snapshot.Len())
+	assert.Equal(t, n/2, mp.Len())
+	assert.Equal(t, 0, snapshot.GetWithFallback("0", -2))
+	assert.False(t, mp.ContainsKey("0"))
+	for i := 1; i < n; i += 2 {
+		val, ok := mp.Get(strconv.Itoa(i))
+		assert.True(t, ok)
+		assert.Equal(t, i, val)
+	}
+	assert.Same(t, mp, mp.Without("missing"))
+
+	sum := 0
+	mp.ForEach(func(_ string, val int) bool {
+		sum += val
+		return false
+	})
+	assert.Equal(t, n*n/4, sum)
+	assert.Len(t, mp.KeysSlice(), n/2)
+	assert.Equal(t, n/2, mp.Values().Len())
+}
+
+func TestPersistentHashMap_MutatorsPanic(t *testing.T) {
+	var mp maps.Map[string, int] = maps.NewPersistentHashMap[string, int]().With("a", 1)
+	mutators := map[string]func(){
+		"Put":           func() { mp.Put("b", 2) },
+		"PutIfAbsent":   func() { mp.PutIfAbsent("b", 2) },
+		"PutAll":        func() { mp.PutAll(maps.HashMap[string, int]{"b": 2}) },
+		"PutAllEntries": func() { mp.PutAllEntries(maps.Entry[string, int]{Key: "b", Value: 2}) },
+		"Remove":        func() { mp.Remove("a") },
+		"Replace":       func() { mp.Replace("a", 2) },
+		"Clear":         func() { mp.Clear() },
+	}
+	for name, mutatorFunc := range mutators {
+		t.Run(name, func(t *testing.T) {
+			assert.PanicsWithValue(t, maps.ErrImmutable, mutatorFunc)
+			assert.Equal(t, 1, mp.Len())
+			assert.Equal(t, 1, mp.GetWithFallback("a", 0))
+		})
+	}
+}
+
+func TestPersistentHashMap_Collisions(t *testing.T) {
+	// every key shares the same hash, so the whole map lives in a single collision node
+	mp := maps.NewPersistentHashMapWithHashFunc[int, string](func(int) uint64 {
+		return 42
+	})
+	mp = mp.With(1, "foo").With(2, "bar").With(3, "baz").With(2, "qux")
+	assert.Equal(t, 3, mp.Len())
+	val, _ := mp.Get(2)
+	assert.Equal(t, "qux", val)
+
+	mp = mp.Without(1).Without(3)
+	assert.Equal(t, 1, mp.Len())
+	assert.False(t, mp.ContainsKey(1))
+	assert.Equal(t, []int{2}, mp.KeysSlice())
+	assert.Equal(t, 0, mp.Without(2).Len())
+}
+
+func TestPersistentHashMap_Transient(t *testing.T) {
+	base := (&maps.PersistentHashMap[int, int]{}).With(-1, -1)
+	transient := base.Transient()
+	for i := 0; i < 1000; i++ {
+		transient.Put(i, i*i)
+	}
+	transient.Remove(-1).Remove(500)
+	assert.Equal(t, 999, transient.Len())
+
+	built := transient.Persistent()
+	assert.Panics(t, func() {
+		transient.Put(1, 1)
+	})
+	assert.Equal(t, 1, base.Len())
+	assert.True(t, base.ContainsKey(-1))
+	assert.Equal(t, 999, built.Len())
+	val, _ := built.Get(30)
+	assert.Equal(t, 900, val)
+
+	// a new transient must copy the nodes owned by the persisted one
+	modified := built.Transient().Put(30, 0).Remove(31).Persistent()
+	val, _ = built.Get(30)
+	assert.Equal(t, 900, val)
+	assert.True(t, built.ContainsKey(31))
+	assert.False(t, modified.ContainsKey(31))
+
+	keys := modified.KeysSlice()
+	sort.Ints(keys)
+	assert.Equal(t, 0, keys[0])
+	assert.Equal(t, 999, keys[len(keys)-1])
+}
@@ -0,0 +1,45 @@
+//go:build go1.23
+
+package maps
+
+import "iter"
+
+// All returns an iter.Seq2 over the key-value mappings of src, making it usable in range-over-func loops and with
+// the standard maps package (e.g., maps.Collect).
+//
+// e.g. for k, v := range maps.All(src) { ... }
+func All[K comparable, V any](src Map[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		src.ForEach(func(key K, val V) bool {
+			return !yield(key, val)
+		})
+	}
+}
+
+// Keys returns an iter.Seq over the keys of src.
+func Keys[K comparable, V any](src Map[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		src.ForEach(func(key K, _ V) bool {
+			return !yield(key)
+		})
+	}
+}
+
+// Values returns an iter.Seq over the values of src.
+func Values[K comparable, V any](src Map[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		src.ForEach(func(_ K, val V) bool {
+			return !yield(val)
+		})
+	}
+}
+
+// NewHashMapFromSeq allocates a new HashMap instance holding the key-value pairs yielded by seq. If a key is yielded
+// more than once, its last value is kept.
+func NewHashMapFromSeq[K comparable, V any](seq iter.Seq2[K, V]) HashMap[K, V] {
+	h := HashMap[K, V]{}
+	for key, val := range seq {
+		h[key] = val
+	}
+	return h
+}
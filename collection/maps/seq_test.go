@@ -0,0 +1,41 @@
+//go:build go1.23
+
+package maps_test
+
+import (
+	stdmaps "maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/maps"
+)
+
+func TestAll(t *testing.T) {
+	m := maps.NewTreeMap[string, int]()
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("c", 3)
+
+	var keys []string
+	var values []int
+	for k, v := range maps.All[string, int](m) {
+		if k == "c" {
+			break
+		}
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	assert.Equal(t, []string{"a", "b"}, keys)
+	assert.Equal(t, []int{1, 2}, values)
+	assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(maps.Keys[string, int](m)))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(maps.Values[string, int](m)))
+	assert.Equal(t, map[string]int{"a": 1, "b": 2, "c": 3}, stdmaps.Collect(maps.All[string, int](m)))
+}
+
+func TestNewHashMapFromSeq(t *testing.T) {
+	assert.Equal(t, maps.HashMap[string, int]{}, maps.NewHashMapFromSeq(stdmaps.All(map[string]int{})))
+	assert.Equal(t, maps.HashMap[int, string]{0: "a", 1: "c"},
+		maps.NewHashMapFromSeq(slices.All([]string{"a", "c"})))
+}
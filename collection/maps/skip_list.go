@@ -1,3 +1,538 @@
 package maps
 
-// TODO: IMPLEMENT ME
+import (
+	"math/bits"
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+)
+
+// skipListMaxLevel is the maximum number of levels a SkipListMap node may span. With a promotion probability of
+// 1/2, it comfortably supports up to 2^32 entries.
+const skipListMaxLevel = 32
+
+// skipListNode is a structure used to hold a key, an atomically swappable value and the forward references of every
+// level the node spans. A node is logically removed once marked and physically unlinked afterward.
+type skipListNode[K comparable, V any] struct {
+	key         K
+	value       atomic.Pointer[V]
+	next        []atomic.Pointer[skipListNode[K, V]]
+	mu          sync.Mutex
+	marked      atomic.Bool
+	fullyLinked atomic.Bool
+}
+
+func newSkipListNode[K comparable, V any](key K, val V, level int) *skipListNode[K, V] {
+	node := &skipListNode[K, V]{
+		key:  key,
+		next: make([]atomic.Pointer[skipListNode[K, V]], level),
+	}
+	node.value.Store(&val)
+	return node
+}
+
+func (n *skipListNode[K, V]) topLevel() int {
+	return len(n.next)
+}
+
+func (n *skipListNode[K, V]) isAlive() bool {
+	return n.fullyLinked.Load() && !n.marked.Load()
+}
+
+func (n *skipListNode[K, V]) load() V {
+	return *n.value.Load()
+}
+
+// skipList is the lazy concurrent skip list shared by a SkipListMap and all of its views.
+type skipList[K comparable, V any] struct {
+	head       *skipListNode[K, V]
+	comparator collection.ComparatorFunc[K]
+	len        atomic.Int64
+}
+
+// skipListBound is a key limiting a SkipListMap view.
+type skipListBound[K any] struct {
+	key       K
+	inclusive bool
+}
+
+// SkipListMap is the concurrent skip list implementation of Map. Entries are sorted according to the natural ordering
+// of their keys (collection.Ordered), or by a collection.ComparatorFunc provided at map creation time.
+//
+// This implementation is safe for concurrent use by multiple goroutines without external locking. It follows the
+// lazy skip list algorithm: read routines (e.g., Get, ContainsKey, ForEach) never acquire locks, while writers only
+// lock the predecessor nodes they modify, so operations on distinct regions of the map do not contend.
+// Get, Put and Remove take O(log n) expected time.
+//
+// Iteration routines traverse entries in ascending key order and are weakly consistent: they never fail because of
+// concurrent modifications, but they may or may not reflect mutations made after the traversal started.
+//
+// Use SubMap, HeadMap and TailMap to obtain live range views of a map.
+type SkipListMap[K comparable, V any] struct {
+	list *skipList[K, V]
+	// lowerBound and upperBound restrict a view; a nil bound means the map is unbounded on that side.
+	lowerBound *skipListBound[K]
+	upperBound *skipListBound[K]
+}
+
+var _ Map[string, int] = &SkipListMap[string, int]{}
+
+// NewSkipListMap allocates a new SkipListMap instance sorting keys by their natural order.
+func NewSkipListMap[K collection.Ordered, V any]() *SkipListMap[K, V] {
+	return NewSkipListMapWithComparator[K, V](collection.Compare[K])
+}
+
+// NewSkipListMapWithComparator allocates a new SkipListMap instance sorting keys with the given comparator.
+func NewSkipListMapWithComparator[K comparable, V any](comparator collection.ComparatorFunc[K]) *SkipListMap[K, V] {
+	if comparator == nil {
+		panic("comparator cannot be nil")
+	}
+	var zeroKey K
+	var zeroVal V
+	return &SkipListMap[K, V]{
+		list: &skipList[K, V]{
+			head:       newSkipListNode[K, V](zeroKey, zeroVal, skipListMaxLevel),
+			comparator: comparator,
+		},
+	}
+}
+
+func randomSkipListLevel() int {
+	level := bits.TrailingZeros64(rand.Uint64()) + 1
+	if level > skipListMaxLevel {
+		return skipListMaxLevel
+	}
+	return level
+}
+
+// find fills preds and succs with the nodes surrounding key at every level, returning the highest level where a node
+// holding key was found or -1 if absent.
+func (s *skipList[K, V]) find(key K, preds, succs []*skipListNode[K, V]) int {
+	foundLevel := -1
+	pred := s.head
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && s.comparator(curr.key, key) < 0 {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+		if foundLevel == -1 && curr != nil && s.comparator(key, curr.key) == 0 {
+			foundLevel = level
+		}
+		preds[level] = pred
+		succs[level] = curr
+	}
+	return foundLevel
+}
+
+// getNode returns the live node holding key, or nil if absent. It never acquires locks.
+func (s *skipList[K, V]) getNode(key K) *skipListNode[K, V] {
+	pred := s.head
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil && s.comparator(curr.key, key) < 0 {
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+		if curr != nil && s.comparator(key, curr.key) == 0 {
+			if curr.isAlive() {
+				return curr
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// ceilingNode returns the first live node holding a key greater than (or equal to, if inclusive) key.
+func (s *skipList[K, V]) ceilingNode(key K, inclusive bool) *skipListNode[K, V] {
+	pred := s.head
+	for level := skipListMaxLevel - 1; level >= 0; level-- {
+		curr := pred.next[level].Load()
+		for curr != nil {
+			cmp := s.comparator(curr.key, key)
+			if cmp > 0 || (cmp == 0 && inclusive) {
+				break
+			}
+			pred = curr
+			curr = pred.next[level].Load()
+		}
+	}
+	node := pred.next[0].Load()
+	for node != nil && !node.isAlive() {
+		node = node.next[0].Load()
+	}
+	return node
+}
+
+func unlockSkipListPreds[K comparable, V any](preds []*skipListNode[K, V], highestLocked int) {
+	var prevPred *skipListNode[K, V]
+	for level := 0; level <= highestLocked; level++ {
+		if preds[level] != prevPred {
+			preds[level].mu.Unlock()
+			prevPred = preds[level]
+		}
+	}
+}
+
+// put inserts or updates the entry for key. If onlyIfAbsent is set, existing entries are left untouched.
+// Returns true if a new node was inserted.
+func (s *skipList[K, V]) put(key K, val V, onlyIfAbsent bool) bool {
+	topLevel := randomSkipListLevel()
+	var preds, succs [skipListMaxLevel]*skipListNode[K, V]
+	for {
+		if foundLevel := s.find(key, preds[:], succs[:]); foundLevel != -1 {
+			nodeFound := succs[foundLevel]
+			if !nodeFound.marked.Load() {
+				for !nodeFound.fullyLinked.Load() {
+					// wait until a concurrent insertion of the same key completes
+					runtime.Gosched()
+				}
+				if !onlyIfAbsent {
+					nodeFound.value.Store(&val)
+				}
+				return false
+			}
+			// node is being removed, retry once it gets unlinked
+			continue
+		}
+
+		highestLocked := -1
+		isValid := true
+		var prevPred *skipListNode[K, V]
+		for level := 0; isValid && level < topLevel; level++ {
+			pred, succ := preds[level], succs[level]
+			if pred != prevPred {
+				pred.mu.Lock()
+				highestLocked = level
+				prevPred = pred
+			}
+			isValid = !pred.marked.Load() && (succ == nil || !succ.marked.Load()) && pred.next[level].Load() == succ
+		}
+		if !isValid {
+			unlockSkipListPreds(preds[:], highestLocked)
+			continue
+		}
+
+		newNode := newSkipListNode[K, V](key, val, topLevel)
+		for level := 0; level < topLevel; level++ {
+			newNode.next[level].Store(succs[level])
+		}
+		for level := 0; level < topLevel; level++ {
+			preds[level].next[level].Store(newNode)
+		}
+		newNode.fullyLinked.Store(true)
+		unlockSkipListPreds(preds[:], highestLocked)
+		s.len.Add(1)
+		return true
+	}
+}
+
+// remove logically deletes (marks) and then unlinks the node holding key.
+func (s *skipList[K, V]) remove(key K) (V, bool) {
+	var (
+		preds, succs [skipListMaxLevel]*skipListNode[K, V]
+		victim       *skipListNode[K, V]
+		isMarked     bool
+		zeroVal      V
+	)
+	for {
+		foundLevel := s.find(key, preds[:], succs[:])
+		if foundLevel != -1 {
+			victim = succs[foundLevel]
+		}
+		if !isMarked && (foundLevel == -1 || !victim.isAlive() || victim.topLevel()-1 != foundLevel) {
+			return zeroVal, false
+		}
+
+		if !isMarked {
+			victim.mu.Lock()
+			if victim.marked.Load() {
+				victim.mu.Unlock()
+				return zeroVal, false
+			}
+			victim.marked.Store(true)
+			isMarked = true
+		}
+
+		highestLocked := -1
+		isValid := true
+		var prevPred *skipListNode[K, V]
+		for level := 0; isValid && level < victim.topLevel(); level++ {
+			pred := preds[level]
+			if pred != prevPred {
+				pred.mu.Lock()
+				highestLocked = level
+				prevPred = pred
+			}
+			isValid = !pred.marked.Load() && pred.next[level].Load() == victim
+		}
+		if !isValid {
+			unlockSkipListPreds(preds[:], highestLocked)
+			continue
+		}
+
+		for level := victim.topLevel() - 1; level >= 0; level-- {
+			preds[level].next[level].Store(victim.next[level].Load())
+		}
+		victim.mu.Unlock()
+		unlockSkipListPreds(preds[:], highestLocked)
+		s.len.Add(-1)
+		return victim.load(), true
+	}
+}
+
+func (m *SkipListMap[K, V]) isView() bool {
+	return m.lowerBound != nil || m.upperBound != nil
+}
+
+func (m *SkipListMap[K, V]) tooLow(key K) bool {
+	if m.lowerBound == nil {
+		return false
+	}
+	cmp := m.list.comparator(key, m.lowerBound.key)
+	return cmp < 0 || (cmp == 0 && !m.lowerBound.inclusive)
+}
+
+func (m *SkipListMap[K, V]) tooHigh(key K) bool {
+	if m.upperBound == nil {
+		return false
+	}
+	cmp := m.list.comparator(key, m.upperBound.key)
+	return cmp > 0 || (cmp == 0 && !m.upperBound.inclusive)
+}
+
+func (m *SkipListMap[K, V]) inRange(key K) bool {
+	return !m.tooLow(key) && !m.tooHigh(key)
+}
+
+// firstNode returns the first live node within this map's range.
+func (m *SkipListMap[K, V]) firstNode() *skipListNode[K, V] {
+	if m.lowerBound != nil {
+		return m.list.ceilingNode(m.lowerBound.key, m.lowerBound.inclusive)
+	}
+	node := m.list.head.next[0].Load()
+	for node != nil && !node.isAlive() {
+		node = node.next[0].Load()
+	}
+	return node
+}
+
+// forEachNode traverses live nodes within this map's range in ascending key order.
+func (m *SkipListMap[K, V]) forEachNode(predicateFunc func(node *skipListNode[K, V]) bool) {
+	for node := m.firstNode(); node != nil; node = node.next[0].Load() {
+		if !node.isAlive() {
+			continue
+		}
+		if m.tooHigh(node.key) {
+			break
+		}
+		if breakIter := predicateFunc(node); breakIter {
+			break
+		}
+	}
+}
+
+// Get returns the value to which the specified key is mapped, or null if this map contains no mapping for the key.
+func (m *SkipListMap[K, V]) Get(key K) (V, bool) {
+	if !m.inRange(key) {
+		var zeroVal V
+		return zeroVal, false
+	}
+	node := m.list.getNode(key)
+	if node == nil {
+		var zeroVal V
+		return zeroVal, false
+	}
+	return node.load(), true
+}
+
+// GetWithFallback returns the value to which the specified key is mapped, or fallbackValue if this map contains
+// no mapping for the key.
+func (m *SkipListMap[K, V]) GetWithFallback(key K, fallbackValue V) V {
+	if val, ok := m.Get(key); ok {
+		return val
+	}
+	return fallbackValue
+}
+
+// PutSafe associates the specified value with the specified key src this map.
+// Returns ErrKeyOutOfRange if key is outside a view's range.
+func (m *SkipListMap[K, V]) PutSafe(key K, val V) error {
+	if !m.inRange(key) {
+		return ErrKeyOutOfRange
+	}
+	m.list.put(key, val, false)
+	return nil
+}
+
+// Put associates the specified value with the specified key src this map.
+// Keys outside a view's range are ignored; use PutSafe to detect them.
+func (m *SkipListMap[K, V]) Put(key K, val V) {
+	_ = m.PutSafe(key, val)
+}
+
+// PutIfAbsent if the specified key is not already associated with a value (or is mapped to nil) associates
+// it with the given value and returns FALSE, else returns TRUE.
+func (m *SkipListMap[K, V]) PutIfAbsent(key K, val V) bool {
+	if !m.inRange(key) {
+		return false
+	}
+	return m.list.put(key, val, true)
+}
+
+// PutAll copies all mappings from the specified map to this map.
+func (m *SkipListMap[K, V]) PutAll(src Map[K, V]) {
+	src.ForEach(func(key K, val V) bool {
+		m.Put(key, val)
+		return false
+	})
+}
+
+// PutAllEntries copies all mappings from the slice of Entry(es) to this map.
+func (m *SkipListMap[K, V]) PutAllEntries(entries ...Entry[K, V]) {
+	for _, entry := range entries {
+		m.Put(entry.Key, entry.Value)
+	}
+}
+
+// Remove removes the mapping for a key from this map if it is present.
+func (m *SkipListMap[K, V]) Remove(key K) V {
+	if !m.inRange(key) {
+		var zeroVal V
+		return zeroVal
+	}
+	val, _ := m.list.remove(key)
+	return val
+}
+
+// Replace replaces the entry for the specified key only if it is currently mapped to some value.
+func (m *SkipListMap[K, V]) Replace(key K, val V) bool {
+	if !m.inRange(key) {
+		return false
+	}
+	node := m.list.getNode(key)
+	if node == nil {
+		return false
+	}
+	node.value.Store(&val)
+	return true
+}
+
+// ContainsKey returns true if this map contains a mapping for the specified key.
+func (m *SkipListMap[K, V]) ContainsKey(key K) bool {
+	return m.inRange(key) && m.list.getNode(key) != nil
+}
+
+// Len returns the number of key-value mappings src this map.
+// This is a constant-time operation for a map but requires a traversal (O(n)) for range views.
+func (m *SkipListMap[K, V]) Len() int {
+	if !m.isView() {
+		return int(m.list.len.Load())
+	}
+	count := 0
+	m.forEachNode(func(_ *skipListNode[K, V]) bool {
+		count++
+		return false
+	})
+	return count
+}
+
+// Clear removes all mappings from this map.
+func (m *SkipListMap[K, V]) Clear() {
+	m.forEachNode(func(node *skipListNode[K, V]) bool {
+		m.list.remove(node.key)
+		return false
+	})
+}
+
+// Keys returns a collection.Collection view of the keys contained src this map.
+func (m *SkipListMap[K, V]) Keys() collection.Collection[K] {
+	return list.NewSliceList(m.KeysSlice())
+}
+
+// Values returns a collection.Collection view of the values contained src this map.
+func (m *SkipListMap[K, V]) Values() collection.Collection[V] {
+	return list.NewSliceList(m.ValuesSlice())
+}
+
+// KeysSlice returns a slice view of the keys contained src this map.
+func (m *SkipListMap[K, V]) KeysSlice() []K {
+	buf := make([]K, 0)
+	m.forEachNode(func(node *skipListNode[K, V]) bool {
+		buf = append(buf, node.key)
+		return false
+	})
+	return buf
+}
+
+// ValuesSlice returns a slice view of the values contained src this map.
+func (m *SkipListMap[K, V]) ValuesSlice() []V {
+	buf := make([]V, 0)
+	m.forEachNode(func(node *skipListNode[K, V]) bool {
+		buf = append(buf, node.load())
+		return false
+	})
+	return buf
+}
+
+// ForEach traverses through all mappings from this map in ascending key order.
+// Use predicate's return boolean value to indicate a break of the iteration.
+// 'K' represents the key whereas 'V' is the value of a map entry.
+func (m *SkipListMap[K, V]) ForEach(predicateFunc collection.IterablePredicateBiFunc[K, V]) {
+	m.forEachNode(func(node *skipListNode[K, V]) bool {
+		return predicateFunc(node.key, node.load())
+	})
+}
+
+// FirstKey returns the lowest key currently in this map.
+func (m *SkipListMap[K, V]) FirstKey() (K, bool) {
+	node := m.firstNode()
+	if node == nil || m.tooHigh(node.key) {
+		var zeroKey K
+		return zeroKey, false
+	}
+	return node.key, true
+}
+
+func (m *SkipListMap[K, V]) newView(lowerBound, upperBound *skipListBound[K]) *SkipListMap[K, V] {
+	// narrow the requested bounds to the ones of this view
+	if lowerBound == nil || (m.lowerBound != nil && m.tooLow(lowerBound.key)) {
+		lowerBound = m.lowerBound
+	}
+	if upperBound == nil || (m.upperBound != nil && m.tooHigh(upperBound.key)) {
+		upperBound = m.upperBound
+	}
+	return &SkipListMap[K, V]{
+		list:       m.list,
+		lowerBound: lowerBound,
+		upperBound: upperBound,
+	}
+}
+
+// SubMap returns a live view of the portion of this map whose keys range from fromKey, inclusive, to toKey,
+// exclusive. Changes in the view are reflected in this map and vice-versa.
+// Mutations of keys outside the view's range are ignored (PutSafe returns ErrKeyOutOfRange).
+// Panics if fromKey is greater than toKey.
+func (m *SkipListMap[K, V]) SubMap(fromKey, toKey K) *SkipListMap[K, V] {
+	if m.list.comparator(fromKey, toKey) > 0 {
+		panic("fromKey cannot be greater than toKey")
+	}
+	return m.newView(&skipListBound[K]{key: fromKey, inclusive: true}, &skipListBound[K]{key: toKey})
+}
+
+// HeadMap returns a live view of the portion of this map whose keys are strictly less than toKey.
+// Changes in the view are reflected in this map and vice-versa.
+func (m *SkipListMap[K, V]) HeadMap(toKey K) *SkipListMap[K, V] {
+	return m.newView(nil, &skipListBound[K]{key: toKey})
+}
+
+// TailMap returns a live view of the portion of this map whose keys are greater than or equal to fromKey.
+// Changes in the view are reflected in this map and vice-versa.
+func (m *SkipListMap[K, V]) TailMap(fromKey K) *SkipListMap[K, V] {
+	return m.newView(&skipListBound[K]{key: fromKey, inclusive: true}, nil)
+}
@@ -0,0 +1,126 @@
+package maps_test
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutrinocorp/nolan/collection/maps"
+)
+
+func TestSkipListMap_Ordering(t *testing.T) {
+	var mp maps.Map[int, string] = maps.NewSkipListMap[int, string]()
+	for _, key := range []int{5, 3, 9, 1, 7} {
+		mp.Put(key, "val")
+	}
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, mp.KeysSlice())
+	assert.False(t, mp.PutIfAbsent(3, "other"))
+	assert.Equal(t, "val", mp.GetWithFallback(3, ""))
+	assert.True(t, mp.Replace(3, "other"))
+	assert.Equal(t, "other", mp.GetWithFallback(3, ""))
+	assert.Equal(t, "val", mp.Remove(5))
+	assert.False(t, mp.ContainsKey(5))
+	assert.Equal(t, 4, mp.Len())
+	mp.Clear()
+	assert.Equal(t, 0, mp.Len())
+	assert.Empty(t, mp.KeysSlice())
+}
+
+func TestSkipListMap_Views(t *testing.T) {
+	mp := maps.NewSkipListMap[int, int]()
+	for i := 0; i < 10; i++ {
+		mp.Put(i, i*10)
+	}
+
+	tests := []struct {
+		name string
+		view *maps.SkipListMap[int, int]
+		exp  []int
+	}{
+		{name: "sub map", view: mp.SubMap(3, 6), exp: []int{3, 4, 5}},
+		{name: "head map", view: mp.HeadMap(3), exp: []int{0, 1, 2}},
+		{name: "tail map", view: mp.TailMap(7), exp: []int{7, 8, 9}},
+		{name: "nested view", view: mp.SubMap(2, 8).TailMap(0).HeadMap(4), exp: []int{2, 3}},
+		{name: "empty", view: mp.SubMap(5, 5), exp: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, tt.view.KeysSlice())
+			assert.Equal(t, len(tt.exp), tt.view.Len())
+		})
+	}
+
+	view := mp.SubMap(3, 6)
+	view.Put(20, 200) // out of range
+	assert.False(t, mp.ContainsKey(20))
+	assert.ErrorIs(t, view.PutSafe(20, 200), maps.ErrKeyOutOfRange)
+	assert.ErrorIs(t, view.PutSafe(6, 60), maps.ErrKeyOutOfRange)
+	assert.False(t, view.PutIfAbsent(2, 20))
+	assert.NoError(t, view.PutSafe(5, 55))
+	assert.Equal(t, 55, mp.GetWithFallback(5, 0))
+	view.Remove(4)
+	assert.False(t, mp.ContainsKey(4))
+	mp.Put(4, 40)
+	key, ok := view.FirstKey()
+	assert.True(t, ok)
+	assert.Equal(t, 3, key)
+	view.Clear()
+	assert.Equal(t, []int{0, 1, 2, 6, 7, 8, 9}, mp.KeysSlice())
+}
+
+func TestSkipListMap_SubMapInvertedBounds(t *testing.T) {
+	mp := maps.NewSkipListMap[int, int]()
+	assert.PanicsWithValue(t, "fromKey cannot be greater than toKey", func() {
+		mp.SubMap(6, 3)
+	})
+	assert.NotPanics(t, func() {
+		mp.SubMap(3, 3)
+	})
+}
+
+func TestSkipListMap_Concurrent(t *testing.T) {
+	mp := maps.NewSkipListMap[int, int]()
+	const (
+		workers = 8
+		perWork = 500
+	)
+	wg := sync.WaitGroup{}
+	wg.Add(workers * 2)
+	for w := 0; w < workers; w++ {
+		go func(offset int) {
+			defer wg.Done()
+			for i := 0; i < perWork; i++ {
+				mp.Put(offset*perWork+i, i)
+			}
+		}(w)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWork; i++ {
+				mp.Get(i)
+				mp.ForEach(func(_ int, _ int) bool {
+					return true
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, workers*perWork, mp.Len())
+	keys := mp.KeysSlice()
+	assert.True(t, sort.IntsAreSorted(keys))
+
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(offset int) {
+			defer wg.Done()
+			for i := 0; i < perWork; i += 2 {
+				mp.Remove(offset*perWork + i)
+			}
+		}(w)
+	}
+	wg.Wait()
+	assert.Equal(t, workers*perWork/2, mp.Len())
+	assert.Equal(t, workers*perWork/2, len(mp.KeysSlice()))
+}
@@ -0,0 +1,179 @@
+package maps
+
+import (
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+)
+
+// Cell a Table item.
+//
+// R: Row key's type.
+//
+// C: Column key's type.
+//
+// V: Value's type.
+type Cell[R, C comparable, V any] struct {
+	Row    R
+	Column C
+	Value  V
+}
+
+// Table A structure that associates an ordered pair of keys, called a row key and a column key, with a single value.
+// A table cannot contain duplicate pairs of keys; each pair can map to at most one value.
+type Table[R, C comparable, V any] interface {
+	// Put Associates the specified value with the specified row and column keys in this table.
+	Put(row R, col C, val V)
+	// Get Returns the value to which the specified row and column keys are mapped.
+	Get(row R, col C) (V, bool)
+	// Remove Removes the mapping for the specified row and column keys from this table if it is present, returning
+	// its value.
+	Remove(row R, col C) V
+	// Contains Returns true if this table contains a mapping for the specified row and column keys.
+	Contains(row R, col C) bool
+	// ContainsRow Returns true if this table contains a mapping with the specified row key.
+	ContainsRow(row R) bool
+	// ContainsColumn Returns true if this table contains a mapping with the specified column key.
+	ContainsColumn(col C) bool
+	// Row Returns a live Map view of the mappings with the specified row key, keyed by column. Changes to the view
+	// are reflected in this table and vice versa.
+	Row(row R) Map[C, V]
+	// Column Returns a live Map view of the mappings with the specified column key, keyed by row. Changes to the view
+	// are reflected in this table and vice versa.
+	Column(col C) Map[R, V]
+	// CellSet Returns a slice copy of all the cells contained in this table.
+	CellSet() []Cell[R, C, V]
+	// Len Returns the number of cells in this table.
+	Len() int
+	// IsEmpty Returns true if this table contains no cells.
+	IsEmpty() bool
+	// Clear Removes all the cells from this table.
+	Clear()
+}
+
+// tableLineView is the live Map view of a table's row (or column) returned by Table.Row (and Table.Column). It is
+// composed of functions bound to the row (or column) key, so a single type serves every Table implementation.
+type tableLineView[K comparable, V any] struct {
+	getFunc     func(key K) (V, bool)
+	putFunc     func(key K, val V)
+	removeFunc  func(key K) V
+	forEachFunc func(predicateFunc collection.IterablePredicateBiFunc[K, V])
+}
+
+var _ Map[string, int] = tableLineView[string, int]{}
+
+// Get returns the value to which the specified key is mapped, or null if this map contains no mapping for the key.
+func (v tableLineView[K, V]) Get(key K) (V, bool) {
+	return v.getFunc(key)
+}
+
+// GetWithFallback returns the value to which the specified key is mapped, or fallbackValue if this map contains
+// no mapping for the key.
+func (v tableLineView[K, V]) GetWithFallback(key K, fallbackValue V) V {
+	if val, ok := v.getFunc(key); ok {
+		return val
+	}
+	return fallbackValue
+}
+
+// Put associates the specified value with the specified key src this map.
+func (v tableLineView[K, V]) Put(key K, val V) {
+	v.putFunc(key, val)
+}
+
+// PutIfAbsent if the specified key is not already associated with a value (or is mapped to nil) associates
+// it with the given value and returns FALSE, else returns TRUE.
+func (v tableLineView[K, V]) PutIfAbsent(key K, val V) bool {
+	if _, ok := v.getFunc(key); ok {
+		return false
+	}
+	v.putFunc(key, val)
+	return true
+}
+
+// PutAll copies all mappings from the specified map to this map.
+func (v tableLineView[K, V]) PutAll(src Map[K, V]) {
+	src.ForEach(func(key K, val V) bool {
+		v.putFunc(key, val)
+		return false
+	})
+}
+
+// PutAllEntries copies all mappings from the slice of Entry(es) to this map.
+func (v tableLineView[K, V]) PutAllEntries(entries ...Entry[K, V]) {
+	for _, entry := range entries {
+		v.putFunc(entry.Key, entry.Value)
+	}
+}
+
+// Remove removes the mapping for a key from this map if it is present.
+func (v tableLineView[K, V]) Remove(key K) V {
+	return v.removeFunc(key)
+}
+
+// Replace replaces the entry for the specified key only if it is currently mapped to some value.
+func (v tableLineView[K, V]) Replace(key K, val V) bool {
+	if _, ok := v.getFunc(key); !ok {
+		return false
+	}
+	v.putFunc(key, val)
+	return true
+}
+
+// ContainsKey returns true if this map contains a mapping for the specified key.
+func (v tableLineView[K, V]) ContainsKey(key K) bool {
+	_, ok := v.getFunc(key)
+	return ok
+}
+
+// Len returns the number of key-value mappings src this map. Takes O(n) time.
+func (v tableLineView[K, V]) Len() int {
+	count := 0
+	v.forEachFunc(func(K, V) bool {
+		count++
+		return false
+	})
+	return count
+}
+
+// Clear removes all mappings from this map.
+func (v tableLineView[K, V]) Clear() {
+	for _, key := range v.KeysSlice() {
+		v.removeFunc(key)
+	}
+}
+
+// Keys returns a collection.Collection copy of the keys contained src this map.
+func (v tableLineView[K, V]) Keys() collection.Collection[K] {
+	return list.NewSliceList(v.KeysSlice())
+}
+
+// Values returns a collection.Collection copy of the values contained src this map.
+func (v tableLineView[K, V]) Values() collection.Collection[V] {
+	return list.NewSliceList(v.ValuesSlice())
+}
+
+// KeysSlice returns a slice copy of the keys contained src this map.
+func (v tableLineView[K, V]) KeysSlice() []K {
+	buf := make([]K, 0)
+	v.forEachFunc(func(key K, _ V) bool {
+		buf = append(buf, key)
+		return false
+	})
+	return buf
+}
+
+// ValuesSlice returns a slice copy of the values contained src this map.
+func (v tableLineView[K, V]) ValuesSlice() []V {
+	buf := make([]V, 0)
+	v.forEachFunc(func(_ K, val V) bool {
+		buf = append(buf, val)
+		return false
+	})
+	return buf
+}
+
+// ForEach traverses through all mappings from this map. Use predicate's return boolean value to indicate
+// a break of the iteration. 'K' represents the key whereas 'V' is the value of a map entry.
+func (v tableLineView[K, V]) ForEach(predicateFunc collection.IterablePredicateBiFunc[K, V]) {
+	v.forEachFunc(predicateFunc)
+}
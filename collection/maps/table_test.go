@@ -0,0 +1,115 @@
+package maps_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/maps"
+)
+
+func TestTable(t *testing.T) {
+	tests := []struct {
+		name        string
+		factoryFunc func() maps.Table[string, int, float64]
+	}{
+		{
+			name: "hash",
+			factoryFunc: func() maps.Table[string, int, float64] {
+				return maps.NewHashTable[string, int, float64]()
+			},
+		},
+		{
+			name: "hash zero-value",
+			factoryFunc: func() maps.Table[string, int, float64] {
+				return &maps.HashTable[string, int, float64]{}
+			},
+		},
+		{
+			name: "array",
+			factoryFunc: func() maps.Table[string, int, float64] {
+				return maps.NewArrayTable[string, int, float64]([]string{"basic", "pro", "basic"}, []int{1, 12})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// pricing by plan and billing period (months)
+			tbl := tt.factoryFunc()
+			assert.False(t, tbl.ContainsRow("basic"))
+			tbl.Put("basic", 1, 10)
+			tbl.Put("basic", 12, 100)
+			tbl.Put("pro", 1, 20)
+			tbl.Put("pro", 1, 25)
+			assert.Equal(t, 3, tbl.Len())
+
+			price, ok := tbl.Get("pro", 1)
+			assert.True(t, ok)
+			assert.Equal(t, 25.0, price)
+			_, ok = tbl.Get("pro", 12)
+			assert.False(t, ok)
+			assert.True(t, tbl.Contains("basic", 12))
+			assert.True(t, tbl.ContainsRow("pro"))
+			assert.True(t, tbl.ContainsColumn(12))
+			assert.False(t, tbl.ContainsColumn(6))
+
+			assert.ElementsMatch(t, []maps.Cell[string, int, float64]{
+				{Row: "basic", Column: 1, Value: 10},
+				{Row: "basic", Column: 12, Value: 100},
+				{Row: "pro", Column: 1, Value: 25},
+			}, tbl.CellSet())
+
+			assert.Equal(t, 100.0, tbl.Remove("basic", 12))
+			assert.Equal(t, 0.0, tbl.Remove("basic", 12))
+			assert.False(t, tbl.ContainsColumn(12))
+			assert.Equal(t, 2, tbl.Len())
+
+			tbl.Clear()
+			assert.True(t, tbl.IsEmpty())
+			assert.False(t, tbl.ContainsRow("basic"))
+		})
+	}
+}
+
+func TestTable_Views(t *testing.T) {
+	for name, tbl := range map[string]maps.Table[string, int, float64]{
+		"hash":  maps.NewHashTable[string, int, float64](),
+		"array": maps.NewArrayTable[string, int, float64]([]string{"basic", "pro"}, []int{1, 12}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			row := tbl.Row("basic")
+			column := tbl.Column(1)
+			assert.Equal(t, 0, row.Len())
+
+			row.Put(1, 10)
+			row.Put(12, 100)
+			column.Put("pro", 20)
+			assert.Equal(t, 3, tbl.Len())
+			assert.ElementsMatch(t, []int{1, 12}, row.KeysSlice())
+			assert.ElementsMatch(t, []string{"basic", "pro"}, column.KeysSlice())
+			assert.Equal(t, 10.0, column.GetWithFallback("basic", -1))
+			assert.False(t, column.PutIfAbsent("pro", 30))
+			assert.True(t, column.Replace("pro", 30))
+
+			price, _ := tbl.Get("pro", 1)
+			assert.Equal(t, 30.0, price)
+			assert.Equal(t, 30.0, column.Remove("pro"))
+			assert.False(t, tbl.ContainsRow("pro"))
+
+			row.Clear()
+			assert.True(t, tbl.IsEmpty())
+			assert.Equal(t, 0, column.Len())
+		})
+	}
+}
+
+func TestArrayTable_UnknownKeys(t *testing.T) {
+	tbl := maps.NewArrayTable[string, string, bool]([]string{"tenant-a", "tenant-b"}, []string{"beta"})
+	assert.ErrorIs(t, tbl.PutSafe("tenant-c", "beta", true), maps.ErrUnknownTableKey)
+	tbl.Put("tenant-a", "alpha", true)
+	assert.True(t, tbl.IsEmpty())
+	assert.NoError(t, tbl.PutSafe("tenant-b", "beta", true))
+	assert.Equal(t, []maps.Cell[string, string, bool]{{Row: "tenant-b", Column: "beta", Value: true}}, tbl.CellSet())
+	assert.Equal(t, []string{"tenant-a", "tenant-b"}, tbl.RowKeys())
+	assert.Equal(t, []string{"beta"}, tbl.ColumnKeys())
+}
@@ -1,3 +1,201 @@
 package maps
 
-// TODO: IMPLEMENT ME
+import (
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/collection/tree"
+)
+
+// TreeMap is the tree.RedBlackTree implementation of Map.
+// The map is sorted according to the natural ordering of its keys (collection.Ordered), or by a
+// collection.ComparatorFunc provided at map creation time.
+//
+// This implementation provides guaranteed O(log n) time cost for the ContainsKey, Get, Put and Remove operations
+// along with navigation routines (e.g., FloorKey, CeilingKey). Iteration routines (e.g., ForEach, KeysSlice)
+// traverse entries in ascending key order.
+type TreeMap[K comparable, V any] struct {
+	tree *tree.RedBlackTree[K, V]
+}
+
+var _ Map[string, int] = &TreeMap[string, int]{}
+
+// NewTreeMap allocates a new TreeMap instance sorting keys by their natural order.
+func NewTreeMap[K collection.Ordered, V any]() *TreeMap[K, V] {
+	return &TreeMap[K, V]{
+		tree: tree.NewOrderedRedBlackTree[K, V](),
+	}
+}
+
+// NewTreeMapWithComparator allocates a new TreeMap instance sorting keys with the given comparator.
+func NewTreeMapWithComparator[K comparable, V any](comparator collection.ComparatorFunc[K]) *TreeMap[K, V] {
+	return &TreeMap[K, V]{
+		tree: tree.NewRedBlackTree[K, V](comparator),
+	}
+}
+
+// Get returns the value to which the specified key is mapped, or null if this map contains no mapping for the key.
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	return m.tree.Get(key)
+}
+
+// GetWithFallback returns the value to which the specified key is mapped, or fallbackValue if this map contains
+// no mapping for the key.
+func (m *TreeMap[K, V]) GetWithFallback(key K, fallbackValue V) V {
+	if val, ok := m.tree.Get(key); ok {
+		return val
+	}
+	return fallbackValue
+}
+
+// Put associates the specified value with the specified key src this map.
+func (m *TreeMap[K, V]) Put(key K, val V) {
+	m.tree.Put(key, val)
+}
+
+// PutIfAbsent if the specified key is not already associated with a value (or is mapped to nil) associates
+// it with the given value and returns FALSE, else returns TRUE.
+func (m *TreeMap[K, V]) PutIfAbsent(key K, val V) bool {
+	if m.tree.ContainsKey(key) {
+		return false
+	}
+	m.tree.Put(key, val)
+	return true
+}
+
+// PutAll copies all mappings from the specified map to this map.
+func (m *TreeMap[K, V]) PutAll(src Map[K, V]) {
+	src.ForEach(func(key K, val V) bool {
+		m.tree.Put(key, val)
+		return false
+	})
+}
+
+// PutAllEntries copies all mappings from the slice of Entry(es) to this map.
+func (m *TreeMap[K, V]) PutAllEntries(entries ...Entry[K, V]) {
+	for _, entry := range entries {
+		m.tree.Put(entry.Key, entry.Value)
+	}
+}
+
+// Remove removes the mapping for a key from this map if it is present.
+func (m *TreeMap[K, V]) Remove(key K) V {
+	val, _ := m.tree.Remove(key)
+	return val
+}
+
+// Replace replaces the entry for the specified key only if it is currently mapped to some value.
+func (m *TreeMap[K, V]) Replace(key K, val V) bool {
+	if !m.tree.ContainsKey(key) {
+		return false
+	}
+	m.tree.Put(key, val)
+	return true
+}
+
+// ContainsKey returns true if this map contains a mapping for the specified key.
+func (m *TreeMap[K, V]) ContainsKey(key K) bool {
+	return m.tree.ContainsKey(key)
+}
+
+// Len returns the number of key-value mappings src this map.
+func (m *TreeMap[K, V]) Len() int {
+	return m.tree.Len()
+}
+
+// Clear removes all mappings from this map.
+func (m *TreeMap[K, V]) Clear() {
+	m.tree.Clear()
+}
+
+// Keys returns a collection.Collection view of the keys contained src this map.
+func (m *TreeMap[K, V]) Keys() collection.Collection[K] {
+	return list.NewSliceList(m.KeysSlice())
+}
+
+// Values returns a collection.Collection view of the values contained src this map.
+func (m *TreeMap[K, V]) Values() collection.Collection[V] {
+	return list.NewSliceList(m.ValuesSlice())
+}
+
+// KeysSlice returns a slice view of the keys contained src this map.
+func (m *TreeMap[K, V]) KeysSlice() []K {
+	buf := make([]K, 0, m.tree.Len())
+	m.tree.ForEach(func(key K, _ V) bool {
+		buf = append(buf, key)
+		return false
+	})
+	return buf
+}
+
+// ValuesSlice returns a slice view of the values contained src this map.
+func (m *TreeMap[K, V]) ValuesSlice() []V {
+	buf := make([]V, 0, m.tree.Len())
+	m.tree.ForEach(func(_ K, val V) bool {
+		buf = append(buf, val)
+		return false
+	})
+	return buf
+}
+
+// ForEach traverses through all mappings from this map in ascending key order.
+// Use predicate's return boolean value to indicate a break of the iteration.
+// 'K' represents the key whereas 'V' is the value of a map entry.
+func (m *TreeMap[K, V]) ForEach(predicateFunc collection.IterablePredicateBiFunc[K, V]) {
+	m.tree.ForEach(predicateFunc)
+}
+
+// FirstKey returns the lowest key currently in this map.
+func (m *TreeMap[K, V]) FirstKey() (K, bool) {
+	key, _, ok := m.tree.Min()
+	return key, ok
+}
+
+// LastKey returns the highest key currently in this map.
+func (m *TreeMap[K, V]) LastKey() (K, bool) {
+	key, _, ok := m.tree.Max()
+	return key, ok
+}
+
+// FloorKey returns the greatest key less than or equal to the given key.
+func (m *TreeMap[K, V]) FloorKey(key K) (K, bool) {
+	floorKey, _, ok := m.tree.Floor(key)
+	return floorKey, ok
+}
+
+// CeilingKey returns the least key greater than or equal to the given key.
+func (m *TreeMap[K, V]) CeilingKey(key K) (K, bool) {
+	ceilingKey, _, ok := m.tree.Ceiling(key)
+	return ceilingKey, ok
+}
+
+// HigherKey returns the least key strictly greater than the given key.
+func (m *TreeMap[K, V]) HigherKey(key K) (K, bool) {
+	higherKey, _, ok := m.tree.Higher(key)
+	return higherKey, ok
+}
+
+// LowerKey returns the greatest key strictly less than the given key.
+func (m *TreeMap[K, V]) LowerKey(key K) (K, bool) {
+	lowerKey, _, ok := m.tree.Lower(key)
+	return lowerKey, ok
+}
+
+// PollFirst removes and returns the entry associated with the lowest key in this map.
+func (m *TreeMap[K, V]) PollFirst() (Entry[K, V], bool) {
+	key, val, ok := m.tree.Min()
+	if !ok {
+		return Entry[K, V]{}, false
+	}
+	m.tree.Remove(key)
+	return Entry[K, V]{Key: key, Value: val}, true
+}
+
+// PollLast removes and returns the entry associated with the highest key in this map.
+func (m *TreeMap[K, V]) PollLast() (Entry[K, V], bool) {
+	key, val, ok := m.tree.Max()
+	if !ok {
+		return Entry[K, V]{}, false
+	}
+	m.tree.Remove(key)
+	return Entry[K, V]{Key: key, Value: val}, true
+}
@@ -0,0 +1,64 @@
+package maps_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/maps"
+)
+
+func TestTreeMap_Ordering(t *testing.T) {
+	var mp maps.Map[string, int] = maps.NewTreeMap[string, int]()
+	mp.PutAllEntries(
+		maps.Entry[string, int]{Key: "foobar", Value: 4},
+		maps.Entry[string, int]{Key: "baz", Value: 3},
+		maps.Entry[string, int]{Key: "foo", Value: 1},
+		maps.Entry[string, int]{Key: "bar", Value: 2},
+	)
+	assert.Equal(t, []string{"bar", "baz", "foo", "foobar"}, mp.KeysSlice())
+	assert.Equal(t, []int{2, 3, 1, 4}, mp.ValuesSlice())
+	assert.False(t, mp.PutIfAbsent("foo", 10))
+	assert.True(t, mp.Replace("foo", 10))
+	assert.False(t, mp.Replace("qux", 10))
+	assert.Equal(t, 10, mp.Remove("foo"))
+	assert.Equal(t, []string{"bar", "baz", "foobar"}, mp.KeysSlice())
+	assert.Equal(t, 3, mp.Len())
+}
+
+func TestTreeMap_Navigation(t *testing.T) {
+	mp := maps.NewTreeMapWithComparator[string, int](func(a, b string) int {
+		// case-insensitive ordering
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	mp.Put("b", 2)
+	mp.Put("D", 4)
+	mp.Put("f", 6)
+
+	key, ok := mp.FirstKey()
+	assert.True(t, ok)
+	assert.Equal(t, "b", key)
+	key, _ = mp.LastKey()
+	assert.Equal(t, "f", key)
+	key, _ = mp.FloorKey("c")
+	assert.Equal(t, "b", key)
+	key, _ = mp.CeilingKey("d")
+	assert.Equal(t, "D", key)
+	key, _ = mp.HigherKey("d")
+	assert.Equal(t, "f", key)
+	key, _ = mp.LowerKey("d")
+	assert.Equal(t, "b", key)
+	_, ok = mp.LowerKey("a")
+	assert.False(t, ok)
+
+	entry, ok := mp.PollFirst()
+	assert.True(t, ok)
+	assert.Equal(t, maps.Entry[string, int]{Key: "b", Value: 2}, entry)
+	entry, _ = mp.PollLast()
+	assert.Equal(t, maps.Entry[string, int]{Key: "f", Value: 6}, entry)
+	assert.Equal(t, []string{"D"}, mp.KeysSlice())
+	mp.PollFirst()
+	_, ok = mp.PollLast()
+	assert.False(t, ok)
+}
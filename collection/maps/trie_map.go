@@ -0,0 +1,102 @@
+package maps
+
+import (
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/collection/tree"
+)
+
+// TrieMap is the tree.Trie implementation of Map for string keys.
+// Besides regular Map operations, it exposes prefix queries (e.g., KeysWithPrefix, CountWithPrefix,
+// LongestPrefixOf) from the underlying tree.Trie. Iteration routines traverse entries in lexicographical key order.
+type TrieMap[V any] struct {
+	*tree.Trie[V]
+}
+
+var _ Map[string, int] = TrieMap[int]{}
+
+// NewTrieMap allocates a new TrieMap instance.
+func NewTrieMap[V any]() TrieMap[V] {
+	return TrieMap[V]{
+		Trie: tree.NewTrie[V](),
+	}
+}
+
+// GetWithFallback returns the value to which the specified key is mapped, or fallbackValue if this map contains
+// no mapping for the key.
+func (m TrieMap[V]) GetWithFallback(key string, fallbackValue V) V {
+	if val, ok := m.Get(key); ok {
+		return val
+	}
+	return fallbackValue
+}
+
+// PutIfAbsent if the specified key is not already associated with a value (or is mapped to nil) associates
+// it with the given value and returns FALSE, else returns TRUE.
+func (m TrieMap[V]) PutIfAbsent(key string, val V) bool {
+	if m.ContainsKey(key) {
+		return false
+	}
+	m.Put(key, val)
+	return true
+}
+
+// PutAll copies all mappings from the specified map to this map.
+func (m TrieMap[V]) PutAll(src Map[string, V]) {
+	src.ForEach(func(key string, val V) bool {
+		m.Put(key, val)
+		return false
+	})
+}
+
+// PutAllEntries copies all mappings from the slice of Entry(es) to this map.
+func (m TrieMap[V]) PutAllEntries(entries ...Entry[string, V]) {
+	for _, entry := range entries {
+		m.Put(entry.Key, entry.Value)
+	}
+}
+
+// Remove removes the mapping for a key from this map if it is present.
+func (m TrieMap[V]) Remove(key string) V {
+	val, _ := m.Delete(key)
+	return val
+}
+
+// Replace replaces the entry for the specified key only if it is currently mapped to some value.
+func (m TrieMap[V]) Replace(key string, val V) bool {
+	if !m.ContainsKey(key) {
+		return false
+	}
+	m.Put(key, val)
+	return true
+}
+
+// Keys returns a collection.Collection view of the keys contained src this map.
+func (m TrieMap[V]) Keys() collection.Collection[string] {
+	return list.NewSliceList(m.KeysSlice())
+}
+
+// Values returns a collection.Collection view of the values contained src this map.
+func (m TrieMap[V]) Values() collection.Collection[V] {
+	return list.NewSliceList(m.ValuesSlice())
+}
+
+// KeysSlice returns a slice view of the keys contained src this map.
+func (m TrieMap[V]) KeysSlice() []string {
+	buf := make([]string, 0, m.Len())
+	m.ForEach(func(key string, _ V) bool {
+		buf = append(buf, key)
+		return false
+	})
+	return buf
+}
+
+// ValuesSlice returns a slice view of the values contained src this map.
+func (m TrieMap[V]) ValuesSlice() []V {
+	buf := make([]V, 0, m.Len())
+	m.ForEach(func(_ string, val V) bool {
+		buf = append(buf, val)
+		return false
+	})
+	return buf
+}
@@ -0,0 +1,27 @@
+package maps_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/maps"
+)
+
+func TestTrieMap(t *testing.T) {
+	var mp maps.Map[string, int] = maps.NewTrieMap[int]()
+	mp.PutAllEntries(
+		maps.Entry[string, int]{Key: "romane", Value: 1},
+		maps.Entry[string, int]{Key: "romanus", Value: 2},
+		maps.Entry[string, int]{Key: "romulus", Value: 3},
+	)
+	assert.True(t, mp.PutIfAbsent("rubens", 4))
+	assert.False(t, mp.PutIfAbsent("rubens", 5))
+	assert.Equal(t, []string{"romane", "romanus", "romulus", "rubens"}, mp.KeysSlice())
+	assert.Equal(t, 2, mp.Remove("romanus"))
+	assert.Equal(t, -1, mp.GetWithFallback("romanus", -1))
+	assert.Equal(t, 3, mp.Len())
+
+	trieMap := mp.(maps.TrieMap[int])
+	assert.Equal(t, 2, trieMap.CountWithPrefix("rom"))
+}
@@ -1,5 +1,7 @@
 package collection
 
+import "cmp"
+
 // Ordered is a constraint that permits any ordered type: any type
 // that supports the operators < <= >= >.
 // If future releases of Go add new ordered types,
@@ -18,3 +20,18 @@ type Ordered interface {
 		~float32 | ~float64 |
 		~string
 }
+
+// Compare returns
+//
+//	-1 if x is less than y,
+//	 0 if x equals y,
+//	+1 if x is greater than y.
+//
+// For floating-point types, a NaN is considered less than any non-NaN,
+// a NaN is considered equal to a NaN, and -0.0 is equal to 0.0.
+//
+// Delegates to cmp.Compare. Its signature matches ComparatorFunc, so it may be used as the comparator
+// of any ordered structure (e.g., ComparatorFunc[int](Compare[int])).
+func Compare[T Ordered](x, y T) int {
+	return cmp.Compare(x, y)
+}
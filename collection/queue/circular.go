@@ -1,3 +1,317 @@
 package queue
 
-// TODO: IMPLEMENT ME
+import (
+	"github.com/neutrinocorp/nolan/collection"
+)
+
+// OverflowPolicy indicates how a CircularQueue behaves when an element is pushed while it is full.
+type OverflowPolicy uint8
+
+const (
+	// RejectOnOverflow rejects the new element. CircularQueue.Push returns ErrFullQueue.
+	RejectOnOverflow OverflowPolicy = iota
+	// OverwriteOldestOnOverflow evicts the head (oldest element) of the queue to make room for the new element.
+	OverwriteOldestOnOverflow
+	// DropNewestOnOverflow silently discards the new element.
+	DropNewestOnOverflow
+)
+
+// CircularQueue is the fixed-capacity ring buffer implementation of BatchQueue and Deque.
+// The underlying buffer is allocated once on construction; insertion and removal routines never allocate
+// (batch routines only allocate their returned slices).
+//
+// When the queue is full, an OverflowPolicy indicates whether new elements are rejected, dropped or if they
+// overwrite the oldest ones.
+type CircularQueue[T any] struct {
+	buf    []T
+	head   int
+	len    int
+	policy OverflowPolicy
+}
+
+var (
+	_ BatchQueue[string] = &CircularQueue[string]{}
+	_ Deque[string]      = &CircularQueue[string]{}
+)
+
+// NewCircularQueue allocates a new CircularQueue instance holding up to 'capacity' elements.
+func NewCircularQueue[T any](capacity int, policy OverflowPolicy) *CircularQueue[T] {
+	if capacity <= 0 {
+		panic("capacity must be greater than zero")
+	}
+	return &CircularQueue[T]{
+		buf:    make([]T, capacity),
+		policy: policy,
+	}
+}
+
+// index translates a logical position (0 being the head) into a buffer index.
+func (c *CircularQueue[T]) index(pos int) int {
+	return (c.head + pos) % len(c.buf)
+}
+
+func (c *CircularQueue[T]) at(pos int) T {
+	return c.buf[c.index(pos)]
+}
+
+// Cap returns the maximum number of elements this queue can hold.
+func (c *CircularQueue[T]) Cap() int {
+	return len(c.buf)
+}
+
+// IsFull returns true if this queue reached its capacity.
+func (c *CircularQueue[T]) IsFull() bool {
+	return c.len == len(c.buf)
+}
+
+// NewIterator returns an iterator over elements of type T, from head to tail.
+func (c *CircularQueue[T]) NewIterator() collection.Iterator[T] {
+	return &circularIterator[T]{
+		source:               c,
+		currentForwardIndex:  0,
+		currentBackwardIndex: c.len - 1,
+	}
+}
+
+// Add adds an element into this collection. Returns false if the element was rejected or dropped.
+func (c *CircularQueue[T]) Add(v T) bool {
+	if c.IsFull() && c.policy != OverwriteOldestOnOverflow {
+		return false
+	}
+	_ = c.Push(v)
+	return true
+}
+
+// AddAll adds all the elements into this collection.
+func (c *CircularQueue[T]) AddAll(src collection.Collection[T]) bool {
+	wasMod := false
+	src.ForEach(func(a T) bool {
+		if c.Add(a) {
+			wasMod = true
+		}
+		return false
+	})
+	return wasMod
+}
+
+// AddSlice adds all the elements in the specified slice (variadic) to this collection.
+func (c *CircularQueue[T]) AddSlice(items ...T) bool {
+	wasMod := false
+	for _, item := range items {
+		if c.Add(item) {
+			wasMod = true
+		}
+	}
+	return wasMod
+}
+
+// Clear removes all the elements from this collection. Does not de-allocate the underlying buffer.
+func (c *CircularQueue[T]) Clear() {
+	clear(c.buf)
+	c.head = 0
+	c.len = 0
+}
+
+// Len returns the number of elements in this collection.
+func (c *CircularQueue[T]) Len() int {
+	return c.len
+}
+
+// IsEmpty returns true if this collection contains no elements.
+func (c *CircularQueue[T]) IsEmpty() bool {
+	return c.len == 0
+}
+
+// ToSlice returns all the elements from this collection as a slice of T, from head to tail.
+func (c *CircularQueue[T]) ToSlice() []T {
+	if c.len == 0 {
+		return nil
+	}
+	buf := make([]T, 0, c.len)
+	for i := 0; i < c.len; i++ {
+		buf = append(buf, c.at(i))
+	}
+	return buf
+}
+
+// ForEach traverses through all the elements from this collection, from head to tail.
+// Use predicate's return value to indicate a break of the iteration, TRUE meaning a break.
+func (c *CircularQueue[T]) ForEach(predicateFunc collection.IterablePredicateFunc[T]) {
+	for i := 0; i < c.len; i++ {
+		if willBreak := predicateFunc(c.at(i)); willBreak {
+			break
+		}
+	}
+}
+
+// Push Inserts the specified element at the tail of this queue. If the queue is full, the OverflowPolicy is
+// applied; ErrFullQueue is returned if the policy is RejectOnOverflow.
+func (c *CircularQueue[T]) Push(v T) error {
+	if c.IsFull() {
+		switch c.policy {
+		case OverwriteOldestOnOverflow:
+			c.buf[c.head] = v
+			c.head = c.index(1)
+			return nil
+		case DropNewestOnOverflow:
+			return nil
+		default:
+			return ErrFullQueue
+		}
+	}
+	c.buf[c.index(c.len)] = v
+	c.len++
+	return nil
+}
+
+// Remove Retrieves and removes the head of this queue. Returns ErrEmptyQueue if this queue is empty.
+func (c *CircularQueue[T]) Remove() (T, error) {
+	if c.len == 0 {
+		var zeroVal T
+		return zeroVal, ErrEmptyQueue
+	}
+	return c.PollFirst(), nil
+}
+
+// Element Retrieves, but does not remove, the head of this queue. Returns ErrEmptyQueue if this queue is empty.
+func (c *CircularQueue[T]) Element() (T, error) {
+	if c.len == 0 {
+		var zeroVal T
+		return zeroVal, ErrEmptyQueue
+	}
+	return c.PeekFirst(), nil
+}
+
+// Poll Retrieves and removes the head of this queue, or returns zero-value if this queue is empty.
+func (c *CircularQueue[T]) Poll() T {
+	return c.PollFirst()
+}
+
+// Peek Retrieves, but does not remove, the head of this queue, or returns zero-value if this queue is empty.
+func (c *CircularQueue[T]) Peek() T {
+	return c.PeekFirst()
+}
+
+// RemoveMany Retrieves and removes up to n elements from the head of this queue.
+// Returns ErrEmptyQueue if this queue is empty.
+func (c *CircularQueue[T]) RemoveMany(n int) ([]T, error) {
+	if c.len == 0 {
+		return nil, ErrEmptyQueue
+	}
+	return c.PollMany(n), nil
+}
+
+// GetMany Retrieves, but does not remove, up to n elements from the head of this queue.
+// Returns ErrEmptyQueue if this queue is empty.
+func (c *CircularQueue[T]) GetMany(n int) ([]T, error) {
+	if c.len == 0 {
+		return nil, ErrEmptyQueue
+	}
+	return c.PeekMany(n), nil
+}
+
+// PollMany Retrieves and removes up to n elements from the head of this queue, or returns nil if this queue is
+// empty.
+func (c *CircularQueue[T]) PollMany(n int) []T {
+	n = min(n, c.len)
+	if n <= 0 {
+		return nil
+	}
+	buf := make([]T, 0, n)
+	for i := 0; i < n; i++ {
+		buf = append(buf, c.PollFirst())
+	}
+	return buf
+}
+
+// PeekMany Retrieves, but does not remove, up to n elements from the head of this queue, or returns nil if this
+// queue is empty.
+func (c *CircularQueue[T]) PeekMany(n int) []T {
+	n = min(n, c.len)
+	if n <= 0 {
+		return nil
+	}
+	buf := make([]T, 0, n)
+	for i := 0; i < n; i++ {
+		buf = append(buf, c.at(i))
+	}
+	return buf
+}
+
+// PeekFirst retrieves, but does not remove, the first element of this deque.
+func (c *CircularQueue[T]) PeekFirst() T {
+	if c.len == 0 {
+		var zeroVal T
+		return zeroVal
+	}
+	return c.buf[c.head]
+}
+
+// PeekLast retrieves, but does not remove, the last element of this deque.
+func (c *CircularQueue[T]) PeekLast() T {
+	if c.len == 0 {
+		var zeroVal T
+		return zeroVal
+	}
+	return c.at(c.len - 1)
+}
+
+// PollFirst retrieves and removes the first element of this deque.
+func (c *CircularQueue[T]) PollFirst() T {
+	var zeroVal T
+	if c.len == 0 {
+		return zeroVal
+	}
+	v := c.buf[c.head]
+	c.buf[c.head] = zeroVal
+	c.head = c.index(1)
+	c.len--
+	return v
+}
+
+// PollLast retrieves and removes the last element of this deque.
+func (c *CircularQueue[T]) PollLast() T {
+	var zeroVal T
+	if c.len == 0 {
+		return zeroVal
+	}
+	tailIndex := c.index(c.len - 1)
+	v := c.buf[tailIndex]
+	c.buf[tailIndex] = zeroVal
+	c.len--
+	return v
+}
+
+// circularIterator is the implementation of collection.Iterator traversing a CircularQueue from head to tail.
+type circularIterator[T any] struct {
+	source               *CircularQueue[T]
+	currentForwardIndex  int
+	currentBackwardIndex int
+}
+
+var _ collection.Iterator[string] = &circularIterator[string]{}
+
+func (i *circularIterator[T]) HasNext() bool {
+	return i.currentForwardIndex <= i.source.len-1
+}
+
+func (i *circularIterator[T]) Next() T {
+	v := i.source.at(i.currentForwardIndex)
+	i.currentForwardIndex++
+	return v
+}
+
+func (i *circularIterator[T]) HasPrevious() bool {
+	return i.currentBackwardIndex >= 0
+}
+
+func (i *circularIterator[T]) Previous() T {
+	v := i.source.at(i.currentBackwardIndex)
+	i.currentBackwardIndex--
+	return v
+}
+
+func (i *circularIterator[T]) Reset() {
+	i.currentForwardIndex = 0
+	i.currentBackwardIndex = i.source.len - 1
+}
@@ -0,0 +1,88 @@
+package queue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/queue"
+)
+
+func TestCircularQueue_Overflow(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy queue.OverflowPolicy
+		expErr error
+		exp    []int
+	}{
+		{
+			name:   "reject",
+			policy: queue.RejectOnOverflow,
+			expErr: queue.ErrFullQueue,
+			exp:    []int{1, 2, 3},
+		},
+		{
+			name:   "overwrite oldest",
+			policy: queue.OverwriteOldestOnOverflow,
+			expErr: nil,
+			exp:    []int{2, 3, 4},
+		},
+		{
+			name:   "drop newest",
+			policy: queue.DropNewestOnOverflow,
+			expErr: nil,
+			exp:    []int{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := queue.NewCircularQueue[int](3, tt.policy)
+			q.AddSlice(1, 2, 3)
+			assert.True(t, q.IsFull())
+			assert.Equal(t, tt.expErr, q.Push(4))
+			assert.Equal(t, tt.exp, q.ToSlice())
+			assert.Equal(t, tt.exp, q.PeekMany(5))
+
+			iter := q.NewIterator()
+			i := len(tt.exp) - 1
+			for iter.HasPrevious() {
+				assert.Equal(t, tt.exp[i], iter.Previous())
+				i--
+			}
+			assert.Equal(t, -1, i)
+		})
+	}
+}
+
+func TestCircularQueue_Deque(t *testing.T) {
+	q := queue.NewCircularQueue[int](4, queue.OverwriteOldestOnOverflow)
+	q.AddSlice(1, 2, 3, 4, 5, 6) // wraps around the buffer
+	assert.Equal(t, 3, q.PeekFirst())
+	assert.Equal(t, 6, q.PeekLast())
+	assert.Equal(t, 6, q.PollLast())
+	assert.Equal(t, 3, q.PollFirst())
+	assert.NoError(t, q.Push(7))
+	assert.Equal(t, []int{4, 5, 7}, q.PollMany(10))
+
+	_, err := q.Remove()
+	assert.ErrorIs(t, err, queue.ErrEmptyQueue)
+	_, err = q.GetMany(1)
+	assert.ErrorIs(t, err, queue.ErrEmptyQueue)
+	assert.Zero(t, q.PollFirst())
+	assert.Zero(t, q.PollLast())
+	assert.Nil(t, q.ToSlice())
+}
+
+func TestCircularQueue_ZeroAllocs(t *testing.T) {
+	q := queue.NewCircularQueue[int](16, queue.OverwriteOldestOnOverflow)
+	allocs := testing.AllocsPerRun(100, func() {
+		for i := 0; i < 32; i++ {
+			_ = q.Push(i)
+		}
+		for !q.IsEmpty() {
+			q.Poll()
+		}
+	})
+	assert.Zero(t, allocs)
+}
@@ -0,0 +1,8 @@
+package queue
+
+import "errors"
+
+var (
+	ErrEmptyQueue = errors.New("nolan.queue: queue is empty")
+	ErrFullQueue  = errors.New("nolan.queue: queue is full")
+)
@@ -1,3 +1,174 @@
 package queue
 
-// TODO: IMPLEMENT ME
+import (
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/heap"
+	"github.com/neutrinocorp/nolan/collection/list"
+)
+
+// PriorityQueue is the heap.BinaryHeap implementation of BatchQueue. Elements are ordered by a
+// collection.ComparatorFunc, the head of this queue being the element comparing as the smallest one
+// (i.e., the highest priority).
+//
+// Push and Poll take O(log n) time while Peek takes O(1) time.
+// Iteration routines (e.g., ForEach, NewIterator, ToSlice) traverse elements in heap (not priority) order.
+type PriorityQueue[T any] struct {
+	heap *heap.BinaryHeap[T]
+}
+
+var _ BatchQueue[string] = &PriorityQueue[string]{}
+
+// NewPriorityQueue allocates a new PriorityQueue instance ordering elements with the given comparator.
+func NewPriorityQueue[T any](comparator collection.ComparatorFunc[T]) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		heap: heap.NewBinaryHeap[T](comparator),
+	}
+}
+
+// NewOrderedPriorityQueue allocates a new PriorityQueue instance ordering elements by their natural order, smallest
+// elements first.
+func NewOrderedPriorityQueue[T collection.Ordered]() *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		heap: heap.NewOrderedBinaryHeap[T](),
+	}
+}
+
+// NewPriorityQueueFromCollection allocates a new PriorityQueue instance copying 'src' items into it.
+// Takes O(n) time.
+func NewPriorityQueueFromCollection[T any](comparator collection.ComparatorFunc[T],
+	src collection.Collection[T]) *PriorityQueue[T] {
+	return &PriorityQueue[T]{
+		heap: heap.NewBinaryHeapFromCollection[T](comparator, src),
+	}
+}
+
+// NewIterator returns an iterator over elements of type T, in heap order.
+func (p *PriorityQueue[T]) NewIterator() collection.Iterator[T] {
+	return list.NewIterator[T](list.NewSliceList(p.heap.ToSlice()))
+}
+
+// Add adds an element into this collection.
+func (p *PriorityQueue[T]) Add(v T) bool {
+	p.heap.Push(v)
+	return true
+}
+
+// AddAll adds all the elements into this collection.
+func (p *PriorityQueue[T]) AddAll(src collection.Collection[T]) bool {
+	wasMod := false
+	src.ForEach(func(a T) bool {
+		p.heap.Push(a)
+		wasMod = true
+		return false
+	})
+	return wasMod
+}
+
+// AddSlice adds all the elements in the specified slice (variadic) to this collection.
+func (p *PriorityQueue[T]) AddSlice(items ...T) bool {
+	for _, item := range items {
+		p.heap.Push(item)
+	}
+	return len(items) > 0
+}
+
+// Clear removes all the elements from this collection.
+func (p *PriorityQueue[T]) Clear() {
+	p.heap.Clear()
+}
+
+// Len returns the number of elements in this collection.
+func (p *PriorityQueue[T]) Len() int {
+	return p.heap.Len()
+}
+
+// IsEmpty returns true if this collection contains no elements.
+func (p *PriorityQueue[T]) IsEmpty() bool {
+	return p.heap.IsEmpty()
+}
+
+// ToSlice returns all the elements from this collection as a slice of T, in heap order.
+func (p *PriorityQueue[T]) ToSlice() []T {
+	return p.heap.ToSlice()
+}
+
+// ForEach traverses through all the elements from this collection, in heap order.
+// Use predicate's return value to indicate a break of the iteration, TRUE meaning a break.
+func (p *PriorityQueue[T]) ForEach(predicateFunc collection.IterablePredicateFunc[T]) {
+	p.heap.ForEach(predicateFunc)
+}
+
+// Push Inserts the specified element into this queue if it is possible to do so.
+func (p *PriorityQueue[T]) Push(v T) error {
+	p.heap.Push(v)
+	return nil
+}
+
+// Remove Retrieves and removes the head of this queue. Returns ErrEmptyQueue if this queue is empty.
+func (p *PriorityQueue[T]) Remove() (T, error) {
+	v, ok := p.heap.Pop()
+	if !ok {
+		return v, ErrEmptyQueue
+	}
+	return v, nil
+}
+
+// Element Retrieves, but does not remove, the head of this queue. Returns ErrEmptyQueue if this queue is empty.
+func (p *PriorityQueue[T]) Element() (T, error) {
+	v, ok := p.heap.Peek()
+	if !ok {
+		return v, ErrEmptyQueue
+	}
+	return v, nil
+}
+
+// Poll Retrieves and removes the head of this queue, or returns zero-value if this queue is empty.
+func (p *PriorityQueue[T]) Poll() T {
+	v, _ := p.heap.Pop()
+	return v
+}
+
+// Peek Retrieves, but does not remove, the head of this queue, or returns zero-value if this queue is empty.
+func (p *PriorityQueue[T]) Peek() T {
+	v, _ := p.heap.Peek()
+	return v
+}
+
+// RemoveMany Retrieves and removes the n highest-priority elements of this queue, sorted by priority.
+// Returns ErrEmptyQueue if this queue is empty.
+func (p *PriorityQueue[T]) RemoveMany(n int) ([]T, error) {
+	if p.heap.IsEmpty() {
+		return nil, ErrEmptyQueue
+	}
+	return p.PollMany(n), nil
+}
+
+// GetMany Retrieves, but does not remove, the n highest-priority elements of this queue, sorted by priority.
+// Returns ErrEmptyQueue if this queue is empty.
+func (p *PriorityQueue[T]) GetMany(n int) ([]T, error) {
+	if p.heap.IsEmpty() {
+		return nil, ErrEmptyQueue
+	}
+	return p.PeekMany(n), nil
+}
+
+// PollMany Retrieves and removes the n highest-priority elements of this queue, sorted by priority, or returns nil
+// if this queue is empty.
+func (p *PriorityQueue[T]) PollMany(n int) []T {
+	n = min(n, p.heap.Len())
+	if n <= 0 {
+		return nil
+	}
+	buf := make([]T, 0, n)
+	for i := 0; i < n; i++ {
+		v, _ := p.heap.Pop()
+		buf = append(buf, v)
+	}
+	return buf
+}
+
+// PeekMany Retrieves, but does not remove, the n highest-priority elements of this queue, sorted by priority, or
+// returns nil if this queue is empty.
+func (p *PriorityQueue[T]) PeekMany(n int) []T {
+	return p.heap.PeekMany(n)
+}
@@ -0,0 +1,46 @@
+package queue_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/collection/queue"
+)
+
+type task struct {
+	name     string
+	priority int
+}
+
+func TestPriorityQueue(t *testing.T) {
+	src := list.NewSliceList([]task{
+		{name: "low", priority: 1},
+		{name: "critical", priority: 10},
+		{name: "medium", priority: 5},
+	})
+	var q queue.BatchQueue[task] = queue.NewPriorityQueueFromCollection[task](func(a, b task) int {
+		// higher priority first
+		return b.priority - a.priority
+	}, src)
+	assert.NoError(t, q.Push(task{name: "high", priority: 7}))
+
+	head, err := q.Element()
+	assert.NoError(t, err)
+	assert.Equal(t, "critical", head.name)
+	assert.Equal(t, []task{{"critical", 10}, {"high", 7}}, q.PeekMany(2))
+	assert.Equal(t, 4, q.Len())
+
+	items, err := q.RemoveMany(3)
+	assert.NoError(t, err)
+	assert.Equal(t, []task{{"critical", 10}, {"high", 7}, {"medium", 5}}, items)
+	assert.Equal(t, []task{{"low", 1}}, q.PollMany(5))
+
+	_, err = q.Remove()
+	assert.ErrorIs(t, err, queue.ErrEmptyQueue)
+	_, err = q.GetMany(1)
+	assert.ErrorIs(t, err, queue.ErrEmptyQueue)
+	assert.Nil(t, q.PollMany(1))
+	assert.Zero(t, q.Poll())
+}
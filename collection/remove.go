@@ -0,0 +1,90 @@
+package collection
+
+import "github.com/neutrinocorp/nolan/function"
+
+// RemovableCollection a kind of Collection able to remove its elements in place.
+// Note that nolan offers a functional way to remove elements from any Collection (e.g., Remove, RemoveIf, RemoveAll,
+// RetainAll), which relies on this interface if implemented.
+type RemovableCollection[T any] interface {
+	Collection[T]
+	// RemoveIf removes all the elements matching predicateFunc (i.e., the ones it returns TRUE for) from this
+	// collection. Returns true if any element was removed.
+	RemoveIf(predicateFunc function.PredicateFunc[T]) bool
+}
+
+// Remove removes the first occurrence of v from src. Returns true if src contained v.
+//
+// Collections implementing a Remove(v T) bool routine (e.g., set.HashSet) are delegated to; every other one is
+// handled by RemoveIf.
+func Remove[T comparable](src Collection[T], v T) bool {
+	if removable, ok := src.(interface{ Remove(v T) bool }); ok {
+		return removable.Remove(v)
+	}
+
+	isFound := false
+	return RemoveIf(src, func(item T) bool {
+		if isFound || item != v {
+			return false
+		}
+		isFound = true
+		return true
+	})
+}
+
+// RemoveIf removes all the elements matching predicateFunc (i.e., the ones it returns TRUE for) from src. Returns
+// true if any element was removed.
+//
+// RemovableCollection implementations remove elements in place. Every other collection is rebuilt from a copy of
+// the remaining elements, using Clear and AddSlice. Either way, it takes O(n) time for lists.
+func RemoveIf[T any](src Collection[T], predicateFunc function.PredicateFunc[T]) bool {
+	if removable, ok := src.(RemovableCollection[T]); ok {
+		return removable.RemoveIf(predicateFunc)
+	}
+
+	items := src.ToSlice()
+	buf := make([]T, 0, len(items))
+	for _, item := range items {
+		if !predicateFunc(item) {
+			buf = append(buf, item)
+		}
+	}
+	if len(buf) == len(items) {
+		return false
+	}
+	src.Clear()
+	src.AddSlice(buf...)
+	return true
+}
+
+// RemoveAll removes from src every occurrence of the elements contained in the specified collection. Returns true
+// if any element was removed.
+//
+// Elements of items are hashed first, so it takes O(n+m) time instead of O(nm).
+func RemoveAll[T comparable](src Collection[T], items Collection[T]) bool {
+	lookup := newLookup(items)
+	return RemoveIf(src, func(item T) bool {
+		_, ok := lookup[item]
+		return ok
+	})
+}
+
+// RetainAll removes from src every element not contained in the specified collection. Returns true if any element
+// was removed.
+//
+// Elements of items are hashed first, so it takes O(n+m) time instead of O(nm).
+func RetainAll[T comparable](src Collection[T], items Collection[T]) bool {
+	lookup := newLookup(items)
+	return RemoveIf(src, func(item T) bool {
+		_, ok := lookup[item]
+		return !ok
+	})
+}
+
+func newLookup[T comparable](items Collection[T]) map[T]struct{} {
+	lookup := make(map[T]struct{}, items.Len())
+	items.ForEach(func(item T) bool {
+		lookup[item] = struct{}{}
+		return false
+	})
+	return lookup
+}
@@ -0,0 +1,47 @@
+//go:build go1.23
+
+package collection
+
+import "iter"
+
+// forEacher is implemented by iterables able to traverse themselves without allocating a stateful Iterator
+// (e.g., every Collection).
+type forEacher[T any] interface {
+	ForEach(predicateFunc IterablePredicateFunc[T])
+}
+
+// All returns an iter.Seq over the elements of src, making it usable in range-over-func loops and with the standard
+// slices and maps packages (e.g., slices.Collect). Collections are traversed with ForEach; any other Iterable is
+// traversed with a new Iterator.
+//
+// e.g. for v := range collection.All(src) { ... }
+func All[T any](src Iterable[T]) iter.Seq[T] {
+	if traversable, ok := src.(forEacher[T]); ok {
+		return func(yield func(T) bool) {
+			traversable.ForEach(func(v T) bool {
+				return !yield(v)
+			})
+		}
+	}
+	return func(yield func(T) bool) {
+		iterator := src.NewIterator()
+		for iterator.HasNext() {
+			if !yield(iterator.Next()) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iter.Seq over the elements of src in reverse order, using the HasPrevious/Previous routines of
+// a new Iterator.
+func Backward[T any](src Iterable[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		iterator := src.NewIterator()
+		for iterator.HasPrevious() {
+			if !yield(iterator.Previous()) {
+				return
+			}
+		}
+	}
+}
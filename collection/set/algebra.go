@@ -0,0 +1,133 @@
+package set
+
+import "github.com/neutrinocorp/nolan/collection"
+
+// smallerFirst returns a and b ordered by their length, so routines may iterate the smaller set while looking up
+// elements in the larger one.
+func smallerFirst[K comparable](a, b Set[K]) (Set[K], Set[K]) {
+	if a.Len() > b.Len() {
+		return b, a
+	}
+	return a, b
+}
+
+// Union returns a new HashSet holding the elements contained in either a or b.
+func Union[K comparable](a, b Set[K]) HashSet[K] {
+	buf := make(HashSet[K], a.Len()+b.Len())
+	buf.AddAll(a)
+	buf.AddAll(b)
+	return buf
+}
+
+// Intersection returns a new HashSet holding the elements contained in both a and b.
+// Takes O(min(n, m)) time.
+func Intersection[K comparable](a, b Set[K]) HashSet[K] {
+	smaller, larger := smallerFirst(a, b)
+	buf := make(HashSet[K], smaller.Len())
+	smaller.ForEach(func(v K) bool {
+		if larger.Contains(v) {
+			buf[v] = struct{}{}
+		}
+		return false
+	})
+	return buf
+}
+
+// Difference returns a new HashSet holding the elements contained in a but not in b.
+func Difference[K comparable](a, b Set[K]) HashSet[K] {
+	buf := make(HashSet[K], a.Len())
+	a.ForEach(func(v K) bool {
+		if !b.Contains(v) {
+			buf[v] = struct{}{}
+		}
+		return false
+	})
+	return buf
+}
+
+// SymmetricDifference returns a new HashSet holding the elements contained in either a or b, but not in both.
+func SymmetricDifference[K comparable](a, b Set[K]) HashSet[K] {
+	buf := Difference(a, b)
+	b.ForEach(func(v K) bool {
+		if !a.Contains(v) {
+			buf[v] = struct{}{}
+		}
+		return false
+	})
+	return buf
+}
+
+// UnionWith adds to dst every element contained in src. Returns true if dst was modified.
+func UnionWith[K comparable](dst, src Set[K]) bool {
+	return dst.AddAll(src)
+}
+
+// IntersectWith removes from dst every element not contained in src. Returns true if dst was modified.
+func IntersectWith[K comparable](dst, src Set[K]) bool {
+	return collection.RemoveIf[K](dst, func(v K) bool {
+		return !src.Contains(v)
+	})
+}
+
+// DifferenceWith removes from dst every element contained in src. Returns true if dst was modified.
+// Takes O(min(n, m)) time if dst removes elements in constant time (e.g., HashSet, LinkedHashSet).
+func DifferenceWith[K comparable](dst, src Set[K]) bool {
+	if src.Len() < dst.Len() {
+		wasMod := false
+		src.ForEach(func(v K) bool {
+			if collection.Remove[K](dst, v) {
+				wasMod = true
+			}
+			return false
+		})
+		return wasMod
+	}
+	return collection.RemoveIf[K](dst, src.Contains)
+}
+
+// SymmetricDifferenceWith removes from dst every element contained in src while adding the ones it did not contain.
+// Returns true if dst was modified.
+func SymmetricDifferenceWith[K comparable](dst, src Set[K]) bool {
+	// src may be dst itself, so its elements are copied before dst is modified
+	items := src.ToSlice()
+	if len(items) == 0 {
+		return false
+	}
+	for _, v := range items {
+		if !collection.Remove[K](dst, v) {
+			dst.Add(v)
+		}
+	}
+	return true
+}
+
+// IsSubsetOf returns true if every element of a is contained in b.
+// Takes O(n) time, failing fast if a is larger than b.
+func IsSubsetOf[K comparable](a, b Set[K]) bool {
+	if a.Len() > b.Len() {
+		return false
+	}
+	isSubset := true
+	a.ForEach(func(v K) bool {
+		isSubset = b.Contains(v)
+		return !isSubset
+	})
+	return isSubset
+}
+
+// IsSupersetOf returns true if every element of b is contained in a.
+func IsSupersetOf[K comparable](a, b Set[K]) bool {
+	return IsSubsetOf(b, a)
+}
+
+// IsDisjoint returns true if a and b have no elements in common.
+// Takes O(min(n, m)) time.
+func IsDisjoint[K comparable](a, b Set[K]) bool {
+	smaller, larger := smallerFirst(a, b)
+	isDisjoint := true
+	smaller.ForEach(func(v K) bool {
+		isDisjoint = !larger.Contains(v)
+		return !isDisjoint
+	})
+	return isDisjoint
+}
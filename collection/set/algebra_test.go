@@ -0,0 +1,118 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/set"
+)
+
+func newHashSet(items ...int) set.HashSet[int] {
+	st := set.HashSet[int]{}
+	st.AddSlice(items...)
+	return st
+}
+
+func TestAlgebra(t *testing.T) {
+	tests := []struct {
+		name       string
+		a, b       []int
+		union      []int
+		inter      []int
+		diff       []int
+		symDiff    []int
+		isSubset   bool
+		isSuperset bool
+		isDisjoint bool
+	}{
+		{
+			name:       "empty",
+			union:      []int{},
+			inter:      []int{},
+			diff:       []int{},
+			symDiff:    []int{},
+			isSubset:   true,
+			isSuperset: true,
+			isDisjoint: true,
+		},
+		{
+			name:       "overlapping",
+			a:          []int{1, 2, 3, 4},
+			b:          []int{3, 4, 5},
+			union:      []int{1, 2, 3, 4, 5},
+			inter:      []int{3, 4},
+			diff:       []int{1, 2},
+			symDiff:    []int{1, 2, 5},
+			isSubset:   false,
+			isSuperset: false,
+			isDisjoint: false,
+		},
+		{
+			name:       "subset",
+			a:          []int{2, 3},
+			b:          []int{1, 2, 3},
+			union:      []int{1, 2, 3},
+			inter:      []int{2, 3},
+			diff:       []int{},
+			symDiff:    []int{1},
+			isSubset:   true,
+			isSuperset: false,
+			isDisjoint: false,
+		},
+		{
+			name:       "disjoint",
+			a:          []int{1, 2},
+			b:          []int{3},
+			union:      []int{1, 2, 3},
+			inter:      []int{},
+			diff:       []int{1, 2},
+			symDiff:    []int{1, 2, 3},
+			isSubset:   false,
+			isSuperset: false,
+			isDisjoint: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// operands of different implementations must work together
+			a, b := newHashSet(tt.a...), set.NewLinkedHashSetFromSlice(tt.b)
+
+			assert.ElementsMatch(t, tt.union, set.Union[int](a, b).ToSlice())
+			assert.ElementsMatch(t, tt.inter, set.Intersection[int](a, b).ToSlice())
+			assert.ElementsMatch(t, tt.inter, set.Intersection[int](b, a).ToSlice())
+			assert.ElementsMatch(t, tt.diff, set.Difference[int](a, b).ToSlice())
+			assert.ElementsMatch(t, tt.symDiff, set.SymmetricDifference[int](a, b).ToSlice())
+			assert.Equal(t, tt.isSubset, set.IsSubsetOf[int](a, b))
+			assert.Equal(t, tt.isSuperset, set.IsSupersetOf[int](a, b))
+			assert.Equal(t, tt.isDisjoint, set.IsDisjoint[int](a, b))
+			assert.Equal(t, tt.isDisjoint, set.IsDisjoint[int](b, a))
+			// operands are left untouched
+			assert.ElementsMatch(t, tt.a, a.ToSlice())
+			assert.Equal(t, len(tt.b), b.Len())
+		})
+	}
+}
+
+func TestAlgebra_InPlace(t *testing.T) {
+	dst := newHashSet(1, 2, 3, 4)
+	assert.True(t, set.UnionWith[int](dst, newHashSet(4, 5)))
+	assert.False(t, set.UnionWith[int](dst, newHashSet(1)))
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, dst.ToSlice())
+
+	assert.True(t, set.IntersectWith[int](dst, newHashSet(1, 2, 3, 9)))
+	assert.False(t, set.IntersectWith[int](dst, newHashSet(1, 2, 3)))
+	assert.ElementsMatch(t, []int{1, 2, 3}, dst.ToSlice())
+
+	// src smaller and larger than dst take different paths
+	assert.True(t, set.DifferenceWith[int](dst, newHashSet(3)))
+	assert.True(t, set.DifferenceWith[int](dst, newHashSet(2, 7, 8, 9)))
+	assert.False(t, set.DifferenceWith[int](dst, newHashSet(5)))
+	assert.ElementsMatch(t, []int{1}, dst.ToSlice())
+
+	linked := set.NewLinkedHashSetFromSlice([]int{1, 2, 3})
+	assert.True(t, set.SymmetricDifferenceWith[int](linked, newHashSet(3, 4)))
+	assert.Equal(t, []int{1, 2, 4}, linked.ToSlice())
+	assert.True(t, set.SymmetricDifferenceWith[int](linked, linked))
+	assert.True(t, linked.IsEmpty())
+}
@@ -0,0 +1,142 @@
+package set
+
+// DisjointSet is a union-find structure keeping track of a partition of elements into disjoint sets.
+// Every set is identified by one of its elements, its representative.
+//
+// Sets are merged by size and paths are compressed on lookups, making Find, Union and Connected take amortized
+// O(α(n)) time, where α is the (practically constant) inverse Ackermann function.
+type DisjointSet[K comparable] struct {
+	indexes  map[K]int
+	elements []K
+	// parents holds the index of the parent of every element; roots are their own parent.
+	parents []int
+	// sizes holds the number of elements of every set, only meaningful for roots.
+	sizes    []int
+	setCount int
+}
+
+// NewDisjointSet allocates a new DisjointSet instance, placing every item in its own set.
+func NewDisjointSet[K comparable](items ...K) *DisjointSet[K] {
+	d := &DisjointSet[K]{
+		indexes:  make(map[K]int, len(items)),
+		elements: make([]K, 0, len(items)),
+		parents:  make([]int, 0, len(items)),
+		sizes:    make([]int, 0, len(items)),
+	}
+	for _, item := range items {
+		d.Add(item)
+	}
+	return d
+}
+
+// Add places an element in a new set of its own. Returns false if the element was already present.
+func (d *DisjointSet[K]) Add(v K) bool {
+	if _, ok := d.indexes[v]; ok {
+		return false
+	}
+	index := len(d.elements)
+	d.indexes[v] = index
+	d.elements = append(d.elements, v)
+	d.parents = append(d.parents, index)
+	d.sizes = append(d.sizes, 1)
+	d.setCount++
+	return true
+}
+
+// Contains returns true if this structure contains the specified element.
+func (d *DisjointSet[K]) Contains(v K) bool {
+	_, ok := d.indexes[v]
+	return ok
+}
+
+func (d *DisjointSet[K]) findRoot(index int) int {
+	for d.parents[index] != index {
+		// path halving: point every visited element to its grandparent
+		d.parents[index] = d.parents[d.parents[index]]
+		index = d.parents[index]
+	}
+	return index
+}
+
+// Find returns the representative of the set containing the specified element.
+// Returns false if the element is not present.
+func (d *DisjointSet[K]) Find(v K) (K, bool) {
+	index, ok := d.indexes[v]
+	if !ok {
+		var zeroVal K
+		return zeroVal, false
+	}
+	return d.elements[d.findRoot(index)], true
+}
+
+// Union merges the sets containing the specified elements, adding missing elements first.
+// Returns false if both elements already belonged to the same set.
+func (d *DisjointSet[K]) Union(a, b K) bool {
+	d.Add(a)
+	d.Add(b)
+	rootA, rootB := d.findRoot(d.indexes[a]), d.findRoot(d.indexes[b])
+	if rootA == rootB {
+		return false
+	}
+	if d.sizes[rootA] < d.sizes[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+	d.parents[rootB] = rootA
+	d.sizes[rootA] += d.sizes[rootB]
+	d.setCount--
+	return true
+}
+
+// Connected returns true if both elements are present and belong to the same set.
+func (d *DisjointSet[K]) Connected(a, b K) bool {
+	indexA, okA := d.indexes[a]
+	indexB, okB := d.indexes[b]
+	return okA && okB && d.findRoot(indexA) == d.findRoot(indexB)
+}
+
+// SizeOf returns the number of elements of the set containing the specified element, or 0 if it is not present.
+func (d *DisjointSet[K]) SizeOf(v K) int {
+	index, ok := d.indexes[v]
+	if !ok {
+		return 0
+	}
+	return d.sizes[d.findRoot(index)]
+}
+
+// Len returns the number of elements in this structure.
+func (d *DisjointSet[K]) Len() int {
+	return len(d.elements)
+}
+
+// SetCount returns the number of disjoint sets in this structure.
+func (d *DisjointSet[K]) SetCount() int {
+	return d.setCount
+}
+
+// Sets returns the disjoint sets of this structure. Sets, and elements within them, are ordered by insertion of
+// their first element.
+func (d *DisjointSet[K]) Sets() [][]K {
+	buf := make([][]K, 0, d.setCount)
+	positions := make(map[int]int, d.setCount)
+	for index, element := range d.elements {
+		root := d.findRoot(index)
+		position, ok := positions[root]
+		if !ok {
+			position = len(buf)
+			positions[root] = position
+			buf = append(buf, make([]K, 0, d.sizes[root]))
+		}
+		buf[position] = append(buf[position], element)
+	}
+	return buf
+}
+
+// Clear removes all the elements from this structure.
+func (d *DisjointSet[K]) Clear() {
+	clear(d.indexes)
+	clear(d.elements)
+	d.elements = d.elements[:0]
+	d.parents = d.parents[:0]
+	d.sizes = d.sizes[:0]
+	d.setCount = 0
+}
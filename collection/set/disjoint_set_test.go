@@ -0,0 +1,85 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/set"
+)
+
+func TestDisjointSet(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          []int
+		unions      [][2]int
+		expSets     [][]int
+		expSetCount int
+	}{
+		{
+			name:    "empty",
+			expSets: [][]int{},
+		},
+		{
+			name:        "singletons",
+			in:          []int{1, 2, 3},
+			expSets:     [][]int{{1}, {2}, {3}},
+			expSetCount: 3,
+		},
+		{
+			name:        "unions",
+			in:          []int{1, 2, 3, 4, 5},
+			unions:      [][2]int{{1, 3}, {4, 5}, {3, 5}},
+			expSets:     [][]int{{1, 3, 4, 5}, {2}},
+			expSetCount: 2,
+		},
+		{
+			name:        "union adds missing elements",
+			in:          []int{1},
+			unions:      [][2]int{{2, 3}, {3, 3}},
+			expSets:     [][]int{{1}, {2, 3}},
+			expSetCount: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := set.NewDisjointSet(tt.in...)
+			for _, union := range tt.unions {
+				d.Union(union[0], union[1])
+			}
+			assert.Equal(t, tt.expSets, d.Sets())
+			assert.Equal(t, tt.expSetCount, d.SetCount())
+			for _, s := range tt.expSets {
+				representative, ok := d.Find(s[0])
+				assert.True(t, ok)
+				for _, item := range s {
+					assert.True(t, d.Connected(s[0], item))
+					assert.Equal(t, len(s), d.SizeOf(item))
+					found, _ := d.Find(item)
+					assert.Equal(t, representative, found)
+				}
+			}
+		})
+	}
+}
+
+func TestDisjointSet_Operations(t *testing.T) {
+	d := set.NewDisjointSet[string]()
+	assert.True(t, d.Add("a"))
+	assert.False(t, d.Add("a"))
+	assert.True(t, d.Union("a", "b"))
+	assert.False(t, d.Union("b", "a"))
+	assert.True(t, d.Contains("b"))
+	assert.False(t, d.Connected("a", "c"))
+	_, ok := d.Find("c")
+	assert.False(t, ok)
+	assert.Equal(t, 0, d.SizeOf("c"))
+	assert.Equal(t, 2, d.Len())
+
+	d.Clear()
+	assert.Equal(t, 0, d.Len())
+	assert.Equal(t, 0, d.SetCount())
+	assert.False(t, d.Contains("a"))
+	assert.True(t, d.Add("a"))
+}
@@ -4,11 +4,15 @@ import (
 	"maps"
 
 	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/function"
 )
 
 type HashSet[K comparable] map[K]struct{}
 
-var _ Set[string] = HashSet[string]{}
+var (
+	_ Set[string]                            = HashSet[string]{}
+	_ collection.RemovableCollection[string] = HashSet[string]{}
+)
 
 func (h HashSet[K]) NewIterator() collection.Iterator[K] {
 	return NewIterator[K](h)
@@ -45,6 +49,44 @@ func (h HashSet[K]) AddSlice(items ...K) bool {
 	return wasMod
 }
 
+// Remove removes v from this set. Returns true if the set contained v.
+func (h HashSet[K]) Remove(v K) bool {
+	if _, ok := h[v]; !ok {
+		return false
+	}
+	delete(h, v)
+	return true
+}
+
+// RemoveIf removes all the elements matching predicateFunc (i.e., the ones it returns TRUE for) from this set.
+// Returns true if any element was removed.
+func (h HashSet[K]) RemoveIf(predicateFunc function.PredicateFunc[K]) bool {
+	prevLen := len(h)
+	maps.DeleteFunc(h, func(k K, _ struct{}) bool {
+		return predicateFunc(k)
+	})
+	return len(h) != prevLen
+}
+
+// RemoveAll removes from this set every element contained in the specified collection. Returns true if any element
+// was removed.
+func (h HashSet[K]) RemoveAll(src collection.Collection[K]) bool {
+	wasMod := false
+	src.ForEach(func(a K) bool {
+		if h.Remove(a) {
+			wasMod = true
+		}
+		return false
+	})
+	return wasMod
+}
+
+// RetainAll removes from this set every element not contained in the specified collection. Returns true if any
+// element was removed.
+func (h HashSet[K]) RetainAll(src collection.Collection[K]) bool {
+	return collection.RetainAll[K](h, src)
+}
+
 func (h HashSet[K]) Clear() {
 	maps.DeleteFunc(h, func(_ K, _ struct{}) bool {
 		return true
@@ -3,6 +3,9 @@ package set_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/list"
 	"github.com/neutrinocorp/nolan/collection/set"
 )
 
@@ -16,3 +19,23 @@ func TestHashSet(t *testing.T) {
 	st.Add(6)
 	t.Log(st.ContainsSlice(1, 2, 3, 4, 5, 6))
 }
+
+func TestHashSet_Remove(t *testing.T) {
+	st := set.HashSet[int]{}
+	st.AddSlice(1, 2, 3, 4, 5, 6)
+	assert.True(t, st.Remove(1))
+	assert.False(t, st.Remove(1))
+	assert.True(t, st.RemoveIf(func(v int) bool {
+		return v%2 == 0
+	}))
+	assert.False(t, st.RemoveIf(func(v int) bool {
+		return v > 10
+	}))
+	assert.ElementsMatch(t, []int{3, 5}, st.ToSlice())
+
+	st.AddSlice(1, 2, 4)
+	assert.True(t, st.RemoveAll(list.NewSliceList([]int{2, 4, 8})))
+	assert.False(t, st.RemoveAll(list.NewSliceList([]int{2, 4, 8})))
+	assert.True(t, st.RetainAll(list.NewSliceList([]int{1, 3, 7})))
+	assert.ElementsMatch(t, []int{1, 3}, st.ToSlice())
+}
@@ -1,3 +1,225 @@
 package set
 
-// TODO: IMPLEMENT ME
+import (
+	"github.com/neutrinocorp/nolan/collection"
+)
+
+// linkedHashSetNode is a structure used to hold a key and both, previous and next, pointer reference to neighbor
+// nodes.
+type linkedHashSetNode[K comparable] struct {
+	previous *linkedHashSetNode[K]
+	next     *linkedHashSetNode[K]
+	key      K
+}
+
+// LinkedHashSet hash table and linked list implementation of the Set interface, with predictable iteration order.
+// This implementation differs from HashSet in that it maintains a doubly-linked list running through all of its
+// entries. This linked list defines the iteration ordering, which is the order in which elements were inserted into
+// the set (insertion-order). Note that insertion order is not affected if an element is re-inserted into the set.
+//
+// Add, Contains and Remove take O(1) time. Its iterator traverses elements in insertion order (Next) and in
+// reverse insertion order (Previous).
+type LinkedHashSet[K comparable] struct {
+	nodes map[K]*linkedHashSetNode[K]
+	head  *linkedHashSetNode[K]
+	tail  *linkedHashSetNode[K]
+}
+
+var _ Set[string] = &LinkedHashSet[string]{}
+
+// NewLinkedHashSet allocates a new LinkedHashSet instance.
+func NewLinkedHashSet[K comparable]() *LinkedHashSet[K] {
+	return &LinkedHashSet[K]{
+		nodes: make(map[K]*linkedHashSetNode[K]),
+	}
+}
+
+// NewLinkedHashSetFromSlice allocates a new LinkedHashSet instance copying 'src' items into it.
+func NewLinkedHashSetFromSlice[K comparable](src []K) *LinkedHashSet[K] {
+	st := &LinkedHashSet[K]{
+		nodes: make(map[K]*linkedHashSetNode[K], len(src)),
+	}
+	st.AddSlice(src...)
+	return st
+}
+
+// NewIterator returns an iterator over elements of type K, in insertion order.
+func (l *LinkedHashSet[K]) NewIterator() collection.Iterator[K] {
+	return &linkedHashSetIterator[K]{
+		source:       l,
+		nextNode:     l.head,
+		previousNode: l.tail,
+	}
+}
+
+// Add adds an element into this collection.
+func (l *LinkedHashSet[K]) Add(v K) bool {
+	if l.nodes == nil {
+		l.nodes = make(map[K]*linkedHashSetNode[K])
+	}
+	if _, ok := l.nodes[v]; ok {
+		return false
+	}
+
+	node := &linkedHashSetNode[K]{
+		previous: l.tail,
+		key:      v,
+	}
+	if l.tail == nil {
+		l.head = node
+	} else {
+		l.tail.next = node
+	}
+	l.tail = node
+	l.nodes[v] = node
+	return true
+}
+
+// AddAll adds all the elements into this collection.
+func (l *LinkedHashSet[K]) AddAll(src collection.Collection[K]) bool {
+	wasMod := false
+	src.ForEach(func(a K) bool {
+		if l.Add(a) {
+			wasMod = true
+		}
+		return false
+	})
+	return wasMod
+}
+
+// AddSlice adds all the elements in the specified slice (variadic) to this collection.
+func (l *LinkedHashSet[K]) AddSlice(items ...K) bool {
+	wasMod := false
+	for _, item := range items {
+		if l.Add(item) {
+			wasMod = true
+		}
+	}
+	return wasMod
+}
+
+// Remove removes the specified element from this collection if it is present.
+func (l *LinkedHashSet[K]) Remove(v K) bool {
+	node, ok := l.nodes[v]
+	if !ok {
+		return false
+	}
+
+	delete(l.nodes, v)
+	if node.previous == nil {
+		l.head = node.next
+	} else {
+		node.previous.next = node.next
+	}
+	if node.next == nil {
+		l.tail = node.previous
+	} else {
+		node.next.previous = node.previous
+	}
+	return true
+}
+
+// Clear removes all the elements from this collection.
+func (l *LinkedHashSet[K]) Clear() {
+	clear(l.nodes)
+	l.head = nil
+	l.tail = nil
+}
+
+// Len returns the number of elements in this collection.
+func (l *LinkedHashSet[K]) Len() int {
+	return len(l.nodes)
+}
+
+// IsEmpty returns true if this collection contains no elements.
+func (l *LinkedHashSet[K]) IsEmpty() bool {
+	return len(l.nodes) == 0
+}
+
+// ToSlice returns all the elements from this collection as a slice of K, in insertion order.
+func (l *LinkedHashSet[K]) ToSlice() []K {
+	buf := make([]K, 0, len(l.nodes))
+	for node := l.head; node != nil; node = node.next {
+		buf = append(buf, node.key)
+	}
+	return buf
+}
+
+// Contains returns true if this collection contains the specified element.
+func (l *LinkedHashSet[K]) Contains(v K) bool {
+	_, ok := l.nodes[v]
+	return ok
+}
+
+// ContainsAll returns true if this collection contains all the elements in the specified collection.
+func (l *LinkedHashSet[K]) ContainsAll(src collection.Collection[K]) bool {
+	iter := src.NewIterator()
+	for iter.HasNext() {
+		if _, ok := l.nodes[iter.Next()]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsSlice returns true if this collection contains all the elements in the specified slice.
+func (l *LinkedHashSet[K]) ContainsSlice(src ...K) bool {
+	for _, item := range src {
+		if _, ok := l.nodes[item]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ForEach traverses through all the elements from this collection in insertion order.
+// Use predicate's return value to indicate a break of the iteration, TRUE meaning a break.
+func (l *LinkedHashSet[K]) ForEach(predicateFunc collection.IterablePredicateFunc[K]) {
+	for node := l.head; node != nil; node = node.next {
+		if willBreak := predicateFunc(node.key); willBreak {
+			break
+		}
+	}
+}
+
+// linkedHashSetIterator is the implementation of collection.Iterator traversing the nodes of a LinkedHashSet.
+type linkedHashSetIterator[K comparable] struct {
+	source       *LinkedHashSet[K]
+	nextNode     *linkedHashSetNode[K]
+	previousNode *linkedHashSetNode[K]
+}
+
+var _ collection.Iterator[string] = &linkedHashSetIterator[string]{}
+
+func (i *linkedHashSetIterator[K]) HasNext() bool {
+	return i.nextNode != nil
+}
+
+func (i *linkedHashSetIterator[K]) Next() K {
+	if i.nextNode == nil {
+		var zeroVal K
+		return zeroVal
+	}
+	key := i.nextNode.key
+	i.nextNode = i.nextNode.next
+	return key
+}
+
+func (i *linkedHashSetIterator[K]) HasPrevious() bool {
+	return i.previousNode != nil
+}
+
+func (i *linkedHashSetIterator[K]) Previous() K {
+	if i.previousNode == nil {
+		var zeroVal K
+		return zeroVal
+	}
+	key := i.previousNode.key
+	i.previousNode = i.previousNode.previous
+	return key
+}
+
+func (i *linkedHashSetIterator[K]) Reset() {
+	i.nextNode = i.source.head
+	i.previousNode = i.source.tail
+}
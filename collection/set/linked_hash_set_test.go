@@ -0,0 +1,75 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/set"
+)
+
+func TestLinkedHashSet_Ordering(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     []string
+		remove []string
+		exp    []string
+	}{
+		{
+			name: "empty",
+			in:   nil,
+			exp:  []string{},
+		},
+		{
+			name: "duplicates keep first insertion",
+			in:   []string{"c", "a", "b", "a", "c"},
+			exp:  []string{"c", "a", "b"},
+		},
+		{
+			name:   "remove head",
+			in:     []string{"c", "a", "b"},
+			remove: []string{"c"},
+			exp:    []string{"a", "b"},
+		},
+		{
+			name:   "remove tail and middle",
+			in:     []string{"c", "a", "b", "d"},
+			remove: []string{"d", "a", "x"},
+			exp:    []string{"c", "b"},
+		},
+		{
+			name:   "remove all",
+			in:     []string{"c", "a"},
+			remove: []string{"a", "c"},
+			exp:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := set.NewLinkedHashSetFromSlice(tt.in)
+			for _, item := range tt.remove {
+				assert.Equal(t, st.Contains(item), st.Remove(item))
+			}
+			assert.Equal(t, tt.exp, st.ToSlice())
+			assert.Equal(t, len(tt.exp), st.Len())
+
+			iter := st.NewIterator()
+			i := 0
+			for iter.HasNext() {
+				assert.Equal(t, tt.exp[i], iter.Next())
+				i++
+			}
+			assert.Equal(t, len(tt.exp), i)
+			i = len(tt.exp) - 1
+			for iter.HasPrevious() {
+				assert.Equal(t, tt.exp[i], iter.Previous())
+				i--
+			}
+			assert.Equal(t, -1, i)
+
+			st.Add("z")
+			assert.Equal(t, "z", st.ToSlice()[st.Len()-1])
+		})
+	}
+}
@@ -0,0 +1,14 @@
+//go:build go1.23
+
+package set
+
+import "iter"
+
+// NewHashSetFromSeq allocates a new HashSet instance holding the elements yielded by seq, dropping duplicates.
+func NewHashSetFromSeq[K comparable](seq iter.Seq[K]) HashSet[K] {
+	h := HashSet[K]{}
+	for v := range seq {
+		h[v] = struct{}{}
+	}
+	return h
+}
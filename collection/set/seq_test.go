@@ -0,0 +1,17 @@
+//go:build go1.23
+
+package set_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/set"
+)
+
+func TestNewHashSetFromSeq(t *testing.T) {
+	assert.Equal(t, set.HashSet[int]{}, set.NewHashSetFromSeq(slices.Values([]int(nil))))
+	assert.Equal(t, set.HashSet[int]{1: {}, 3: {}}, set.NewHashSetFromSeq(slices.Values([]int{3, 1, 3})))
+}
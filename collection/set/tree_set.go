@@ -0,0 +1,406 @@
+package set
+
+import (
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/tree"
+	"github.com/neutrinocorp/nolan/function"
+)
+
+// treeSetBound delimits one end of the range of a TreeSet view.
+type treeSetBound[K comparable] struct {
+	key         K
+	isInclusive bool
+}
+
+// TreeSet is the tree.RedBlackTree implementation of Set.
+// The set is sorted according to the natural ordering of its elements (collection.Ordered), or by a
+// collection.ComparatorFunc provided at set creation time.
+//
+// This implementation provides guaranteed O(log n) time cost for the Add, Contains and Remove operations along with
+// navigation routines (e.g., Floor, Ceiling). Iteration routines (e.g., ForEach, ToSlice) traverse elements in
+// ascending order.
+//
+// SubSet, HeadSet and TailSet return live views over a range of the set: changes to a view are reflected in the set
+// and vice versa. Elements out of the range of a view cannot be added through it. Len takes O(n) time on views.
+type TreeSet[K comparable] struct {
+	tree       *tree.RedBlackTree[K, struct{}]
+	comparator collection.ComparatorFunc[K]
+	// from and to are the bounds of a view; nil if unbounded.
+	from *treeSetBound[K]
+	to   *treeSetBound[K]
+}
+
+var (
+	_ Set[string]                            = &TreeSet[string]{}
+	_ collection.RemovableCollection[string] = &TreeSet[string]{}
+)
+
+// NewTreeSet allocates a new TreeSet instance sorting elements by their natural order.
+func NewTreeSet[K collection.Ordered]() *TreeSet[K] {
+	return NewTreeSetWithComparator[K](collection.Compare[K])
+}
+
+// NewTreeSetWithComparator allocates a new TreeSet instance sorting elements with the given comparator.
+func NewTreeSetWithComparator[K comparable](comparator collection.ComparatorFunc[K]) *TreeSet[K] {
+	return &TreeSet[K]{
+		tree:       tree.NewRedBlackTree[K, struct{}](comparator),
+		comparator: comparator,
+	}
+}
+
+func (s *TreeSet[K]) isView() bool {
+	return s.from != nil || s.to != nil
+}
+
+func (s *TreeSet[K]) isTooLow(v K) bool {
+	if s.from == nil {
+		return false
+	}
+	cmp := s.comparator(v, s.from.key)
+	return cmp < 0 || (cmp == 0 && !s.from.isInclusive)
+}
+
+func (s *TreeSet[K]) isTooHigh(v K) bool {
+	if s.to == nil {
+		return false
+	}
+	cmp := s.comparator(v, s.to.key)
+	return cmp > 0 || (cmp == 0 && !s.to.isInclusive)
+}
+
+func (s *TreeSet[K]) isInRange(v K) bool {
+	return !s.isTooLow(v) && !s.isTooHigh(v)
+}
+
+// NewIterator returns an iterator over the elements of this set in ascending (Next) and descending (Previous) order.
+// Every step takes O(log n) time, and the iterator keeps working if the set is modified meanwhile.
+func (s *TreeSet[K]) NewIterator() collection.Iterator[K] {
+	iter := &treeSetIterator[K]{
+		source: s,
+	}
+	iter.Reset()
+	return iter
+}
+
+// Add adds an element into this set. Returns false if the element was already present or if it is out of the
+// range of this view.
+func (s *TreeSet[K]) Add(v K) bool {
+	if !s.isInRange(v) {
+		return false
+	}
+	return s.tree.Put(v, struct{}{})
+}
+
+// AddAll adds all the elements into this set.
+func (s *TreeSet[K]) AddAll(src collection.Collection[K]) bool {
+	wasMod := false
+	src.ForEach(func(a K) bool {
+		if s.Add(a) {
+			wasMod = true
+		}
+		return false
+	})
+	return wasMod
+}
+
+// AddSlice adds all the elements in the specified slice (variadic) to this set.
+func (s *TreeSet[K]) AddSlice(items ...K) bool {
+	wasMod := false
+	for _, item := range items {
+		if s.Add(item) {
+			wasMod = true
+		}
+	}
+	return wasMod
+}
+
+// Remove removes v from this set. Returns true if the set contained v.
+func (s *TreeSet[K]) Remove(v K) bool {
+	if !s.isInRange(v) {
+		return false
+	}
+	_, ok := s.tree.Remove(v)
+	return ok
+}
+
+// RemoveIf removes all the elements matching predicateFunc (i.e., the ones it returns TRUE for) from this set.
+// Returns true if any element was removed.
+func (s *TreeSet[K]) RemoveIf(predicateFunc function.PredicateFunc[K]) bool {
+	buf := make([]K, 0)
+	s.ForEach(func(v K) bool {
+		if predicateFunc(v) {
+			buf = append(buf, v)
+		}
+		return false
+	})
+	for _, v := range buf {
+		s.tree.Remove(v)
+	}
+	return len(buf) > 0
+}
+
+// Clear removes all the elements from this set. Only the elements within the range of this view are removed.
+func (s *TreeSet[K]) Clear() {
+	if !s.isView() {
+		s.tree.Clear()
+		return
+	}
+	s.RemoveIf(func(K) bool {
+		return true
+	})
+}
+
+// Len returns the number of elements in this set.
+func (s *TreeSet[K]) Len() int {
+	if !s.isView() {
+		return s.tree.Len()
+	}
+	count := 0
+	s.ForEach(func(K) bool {
+		count++
+		return false
+	})
+	return count
+}
+
+// IsEmpty returns true if this set contains no elements.
+func (s *TreeSet[K]) IsEmpty() bool {
+	_, ok := s.First()
+	return !ok
+}
+
+// ToSlice returns all the elements from this set as a slice of K, in ascending order.
+func (s *TreeSet[K]) ToSlice() []K {
+	buf := make([]K, 0)
+	s.ForEach(func(v K) bool {
+		buf = append(buf, v)
+		return false
+	})
+	return buf
+}
+
+// Contains returns true if this set contains the specified element.
+func (s *TreeSet[K]) Contains(v K) bool {
+	return s.isInRange(v) && s.tree.ContainsKey(v)
+}
+
+// ContainsAll returns true if this set contains all the elements in the specified collection.
+func (s *TreeSet[K]) ContainsAll(src collection.Collection[K]) bool {
+	containsAll := true
+	src.ForEach(func(v K) bool {
+		containsAll = s.Contains(v)
+		return !containsAll
+	})
+	return containsAll
+}
+
+// ContainsSlice returns true if this set contains all the elements in the specified slice.
+func (s *TreeSet[K]) ContainsSlice(src ...K) bool {
+	for _, item := range src {
+		if !s.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// ForEach traverses through all the elements from this set in ascending order.
+// Use predicate's return value to indicate a break of the iteration, TRUE meaning a break.
+func (s *TreeSet[K]) ForEach(predicateFunc collection.IterablePredicateFunc[K]) {
+	forEachFunc := func(v K, _ struct{}) bool {
+		return s.isTooHigh(v) || predicateFunc(v)
+	}
+	if s.from == nil {
+		s.tree.ForEach(forEachFunc)
+		return
+	}
+	s.tree.ForEachFrom(s.from.key, s.from.isInclusive, forEachFunc)
+}
+
+// First returns the lowest element currently in this set.
+func (s *TreeSet[K]) First() (K, bool) {
+	var (
+		v  K
+		ok bool
+	)
+	switch {
+	case s.from == nil:
+		v, _, ok = s.tree.Min()
+	case s.from.isInclusive:
+		v, _, ok = s.tree.Ceiling(s.from.key)
+	default:
+		v, _, ok = s.tree.Higher(s.from.key)
+	}
+	return s.checkUpper(v, ok)
+}
+
+// Last returns the highest element currently in this set.
+func (s *TreeSet[K]) Last() (K, bool) {
+	var (
+		v  K
+		ok bool
+	)
+	switch {
+	case s.to == nil:
+		v, _, ok = s.tree.Max()
+	case s.to.isInclusive:
+		v, _, ok = s.tree.Floor(s.to.key)
+	default:
+		v, _, ok = s.tree.Lower(s.to.key)
+	}
+	return s.checkLower(v, ok)
+}
+
+// Floor returns the greatest element in this set less than or equal to the given element.
+func (s *TreeSet[K]) Floor(v K) (K, bool) {
+	floor, _, ok := s.tree.Floor(v)
+	if ok && s.isTooHigh(floor) {
+		return s.Last()
+	}
+	return s.checkLower(floor, ok)
+}
+
+// Ceiling returns the least element in this set greater than or equal to the given element.
+func (s *TreeSet[K]) Ceiling(v K) (K, bool) {
+	ceiling, _, ok := s.tree.Ceiling(v)
+	if ok && s.isTooLow(ceiling) {
+		return s.First()
+	}
+	return s.checkUpper(ceiling, ok)
+}
+
+// Higher returns the least element in this set strictly greater than the given element.
+func (s *TreeSet[K]) Higher(v K) (K, bool) {
+	higher, _, ok := s.tree.Higher(v)
+	if ok && s.isTooLow(higher) {
+		return s.First()
+	}
+	return s.checkUpper(higher, ok)
+}
+
+// Lower returns the greatest element in this set strictly less than the given element.
+func (s *TreeSet[K]) Lower(v K) (K, bool) {
+	lower, _, ok := s.tree.Lower(v)
+	if ok && s.isTooHigh(lower) {
+		return s.Last()
+	}
+	return s.checkLower(lower, ok)
+}
+
+// checkUpper discards v if it lies past the upper bound of this view.
+func (s *TreeSet[K]) checkUpper(v K, ok bool) (K, bool) {
+	if !ok || s.isTooHigh(v) {
+		var zeroVal K
+		return zeroVal, false
+	}
+	return v, true
+}
+
+// checkLower discards v if it lies before the lower bound of this view.
+func (s *TreeSet[K]) checkLower(v K, ok bool) (K, bool) {
+	if !ok || s.isTooLow(v) {
+		var zeroVal K
+		return zeroVal, false
+	}
+	return v, true
+}
+
+// PollFirst removes and returns the lowest element in this set.
+func (s *TreeSet[K]) PollFirst() (K, bool) {
+	v, ok := s.First()
+	if ok {
+		s.tree.Remove(v)
+	}
+	return v, ok
+}
+
+// PollLast removes and returns the highest element in this set.
+func (s *TreeSet[K]) PollLast() (K, bool) {
+	v, ok := s.Last()
+	if ok {
+		s.tree.Remove(v)
+	}
+	return v, ok
+}
+
+// SubSet returns a live view of the portion of this set whose elements range from fromElement, inclusive, to
+// toElement, exclusive. The view is further narrowed by the range of this set if it is a view itself.
+// Panics if fromElement is greater than toElement.
+func (s *TreeSet[K]) SubSet(fromElement, toElement K) *TreeSet[K] {
+	if s.comparator(fromElement, toElement) > 0 {
+		panic("fromElement cannot be greater than toElement")
+	}
+	return s.newView(&treeSetBound[K]{key: fromElement, isInclusive: true}, &treeSetBound[K]{key: toElement})
+}
+
+// HeadSet returns a live view of the portion of this set whose elements are strictly less than toElement.
+func (s *TreeSet[K]) HeadSet(toElement K) *TreeSet[K] {
+	return s.newView(nil, &treeSetBound[K]{key: toElement})
+}
+
+// TailSet returns a live view of the portion of this set whose elements are greater than or equal to fromElement.
+func (s *TreeSet[K]) TailSet(fromElement K) *TreeSet[K] {
+	return s.newView(&treeSetBound[K]{key: fromElement, isInclusive: true}, nil)
+}
+
+// newView returns a view over this set's tree bounded by the tightest of the given bounds and this set's ones.
+func (s *TreeSet[K]) newView(from, to *treeSetBound[K]) *TreeSet[K] {
+	view := &TreeSet[K]{
+		tree:       s.tree,
+		comparator: s.comparator,
+		from:       s.from,
+		to:         s.to,
+	}
+	if from != nil && (view.from == nil || !view.isTooLow(from.key)) {
+		view.from = from
+	}
+	if to != nil && (view.to == nil || !view.isTooHigh(to.key)) {
+		view.to = to
+	}
+	return view
+}
+
+// treeSetIterator is the implementation of collection.Iterator traversing the elements of a TreeSet through
+// navigation routines.
+type treeSetIterator[K comparable] struct {
+	source      *TreeSet[K]
+	next        K
+	hasNext     bool
+	previous    K
+	hasPrevious bool
+}
+
+var _ collection.Iterator[string] = &treeSetIterator[string]{}
+
+func (i *treeSetIterator[K]) HasNext() bool {
+	return i.hasNext
+}
+
+func (i *treeSetIterator[K]) Next() K {
+	if !i.hasNext {
+		var zeroVal K
+		return zeroVal
+	}
+	v := i.next
+	i.next, i.hasNext = i.source.Higher(v)
+	return v
+}
+
+func (i *treeSetIterator[K]) HasPrevious() bool {
+	return i.hasPrevious
+}
+
+func (i *treeSetIterator[K]) Previous() K {
+	if !i.hasPrevious {
+		var zeroVal K
+		return zeroVal
+	}
+	v := i.previous
+	i.previous, i.hasPrevious = i.source.Lower(v)
+	return v
+}
+
+func (i *treeSetIterator[K]) Reset() {
+	i.next, i.hasNext = i.source.First()
+	i.previous, i.hasPrevious = i.source.Last()
+}
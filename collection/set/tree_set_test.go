@@ -0,0 +1,151 @@
+package set_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/set"
+)
+
+func TestTreeSet_Ordering(t *testing.T) {
+	st := set.NewTreeSetWithComparator[string](func(a, b string) int {
+		// case-insensitive ordering
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	assert.True(t, st.AddSlice("foo", "Bar", "baz", "qux"))
+	assert.False(t, st.Add("FOO"))
+	assert.Equal(t, []string{"Bar", "baz", "foo", "qux"}, st.ToSlice())
+	assert.True(t, st.Contains("BAZ"))
+	assert.True(t, st.Remove("baz"))
+	assert.False(t, st.Remove("baz"))
+	assert.Equal(t, 3, st.Len())
+}
+
+func TestTreeSet_Navigation(t *testing.T) {
+	st := set.NewTreeSet[int]()
+	st.AddSlice(50, 10, 40, 20, 30)
+
+	tests := []struct {
+		name  string
+		navFn func(v int) (int, bool)
+		in    int
+		exp   int
+		expOk bool
+	}{
+		{name: "floor exact", navFn: st.Floor, in: 20, exp: 20, expOk: true},
+		{name: "floor between", navFn: st.Floor, in: 25, exp: 20, expOk: true},
+		{name: "floor none", navFn: st.Floor, in: 5},
+		{name: "ceiling exact", navFn: st.Ceiling, in: 20, exp: 20, expOk: true},
+		{name: "ceiling between", navFn: st.Ceiling, in: 25, exp: 30, expOk: true},
+		{name: "ceiling none", navFn: st.Ceiling, in: 55},
+		{name: "higher", navFn: st.Higher, in: 20, exp: 30, expOk: true},
+		{name: "higher none", navFn: st.Higher, in: 50},
+		{name: "lower", navFn: st.Lower, in: 20, exp: 10, expOk: true},
+		{name: "lower none", navFn: st.Lower, in: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, ok := tt.navFn(tt.in)
+			assert.Equal(t, tt.expOk, ok)
+			assert.Equal(t, tt.exp, v)
+		})
+	}
+
+	first, _ := st.First()
+	last, _ := st.Last()
+	assert.Equal(t, 10, first)
+	assert.Equal(t, 50, last)
+	first, _ = st.PollFirst()
+	last, _ = st.PollLast()
+	assert.Equal(t, 10, first)
+	assert.Equal(t, 50, last)
+	assert.Equal(t, []int{20, 30, 40}, st.ToSlice())
+
+	st.Clear()
+	_, ok := st.PollFirst()
+	assert.False(t, ok)
+	assert.True(t, st.IsEmpty())
+}
+
+func TestTreeSet_Views(t *testing.T) {
+	st := set.NewTreeSet[int]()
+	st.AddSlice(10, 20, 30, 40, 50)
+
+	sub := st.SubSet(20, 40)
+	head := st.HeadSet(30)
+	tail := st.TailSet(30)
+	assert.Equal(t, []int{20, 30}, sub.ToSlice())
+	assert.Equal(t, []int{10, 20}, head.ToSlice())
+	assert.Equal(t, []int{30, 40, 50}, tail.ToSlice())
+	assert.Equal(t, 2, sub.Len())
+
+	// views are live
+	st.Add(35)
+	assert.Equal(t, []int{20, 30, 35}, sub.ToSlice())
+	assert.True(t, sub.Add(25))
+	assert.False(t, sub.Add(45))
+	assert.False(t, sub.Contains(10))
+	assert.Equal(t, []int{10, 20, 25, 30, 35, 40, 50}, st.ToSlice())
+
+	// navigation is bounded by the view's range
+	v, ok := sub.Floor(100)
+	assert.True(t, ok)
+	assert.Equal(t, 35, v)
+	v, _ = sub.Ceiling(0)
+	assert.Equal(t, 20, v)
+	_, ok = sub.Higher(35)
+	assert.False(t, ok)
+	_, ok = sub.Lower(20)
+	assert.False(t, ok)
+
+	// views of views keep the tightest range
+	assert.Equal(t, []int{25, 30}, sub.TailSet(22).HeadSet(35).ToSlice())
+	assert.Equal(t, []int{20, 25, 30, 35}, sub.SubSet(0, 100).ToSlice())
+
+	v, _ = sub.PollLast()
+	assert.Equal(t, 35, v)
+	sub.Clear()
+	assert.True(t, sub.IsEmpty())
+	assert.Equal(t, []int{10, 40, 50}, st.ToSlice())
+}
+
+func TestTreeSet_SubSetInvertedBounds(t *testing.T) {
+	st := set.NewTreeSet[int]()
+	assert.PanicsWithValue(t, "fromElement cannot be greater than toElement", func() {
+		st.SubSet(40, 20)
+	})
+	assert.True(t, st.SubSet(20, 20).IsEmpty())
+
+	desc := set.NewTreeSetWithComparator[int](func(a, b int) int {
+		return b - a
+	})
+	desc.AddSlice(10, 20, 30, 40)
+	assert.Equal(t, []int{40, 30}, desc.SubSet(40, 20).ToSlice())
+	assert.Panics(t, func() {
+		desc.SubSet(20, 40)
+	})
+}
+
+func TestTreeSet_Iterator(t *testing.T) {
+	st := set.NewTreeSet[int]()
+	st.AddSlice(3, 1, 4, 5, 2)
+	iter := st.TailSet(2).NewIterator()
+
+	buf := make([]int, 0)
+	for iter.HasNext() {
+		buf = append(buf, iter.Next())
+	}
+	assert.Equal(t, []int{2, 3, 4, 5}, buf)
+
+	buf = buf[:0]
+	for iter.HasPrevious() {
+		buf = append(buf, iter.Previous())
+	}
+	assert.Equal(t, []int{5, 4, 3, 2}, buf)
+
+	iter.Reset()
+	assert.Equal(t, 2, iter.Next())
+	assert.Equal(t, 5, iter.Previous())
+}
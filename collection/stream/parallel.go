@@ -0,0 +1,226 @@
+package stream
+
+import (
+	"context"
+	"sync"
+
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/concurrency/executor"
+	"github.com/neutrinocorp/nolan/function"
+)
+
+// ParallelMapFunc is a function.DelegateBiFuncSafe type used by ParallelStream stages to transform an element,
+// returning either the result or an error aborting the whole pipeline.
+type ParallelMapFunc[T, R any] function.DelegateBiFuncSafe[context.Context, T, R]
+
+// ParallelPredicateFunc is a function.DelegateBiFuncSafe type used by ParallelStream stages to tell if an element
+// must be kept, returning an error aborting the whole pipeline if something fails.
+type ParallelPredicateFunc[T any] function.DelegateBiFuncSafe[context.Context, T, bool]
+
+// ParallelOptions holds the settings of a ParallelStream. Zero-value is ready to use.
+type ParallelOptions struct {
+	// MaxGoroutines is the maximum number of chunks processed at the same time.
+	// Defaults to executor.DefaultMaxGoroutines.
+	MaxGoroutines int64
+	// ChunkSize is the number of elements processed sequentially by a goroutine.
+	// Defaults to an even split of the source between MaxGoroutines goroutines.
+	ChunkSize int
+	// Unordered merges the chunks' results as soon as they are processed instead of keeping the source order,
+	// letting fast chunks skip waiting for slow ones.
+	Unordered bool
+}
+
+func (o ParallelOptions) maxGoroutines() int64 {
+	if o.MaxGoroutines <= 0 {
+		return executor.DefaultMaxGoroutines
+	}
+	return o.MaxGoroutines
+}
+
+func (o ParallelOptions) chunkSize(n int) int {
+	if o.ChunkSize > 0 {
+		return o.ChunkSize
+	}
+	goroutines := int(o.maxGoroutines())
+	return max((n+goroutines-1)/goroutines, 1)
+}
+
+// ParallelStream is a pipeline splitting a collection.Collection into chunks which are processed concurrently through
+// an executor.ConcurrentExecutor. Stages (e.g., Filter, ParallelMap) are fused, so every chunk goes through the whole
+// pipeline within a single goroutine. Like Stream, stages only describe the pipeline; nothing runs until a terminal
+// operation (e.g., Collect, ForEach) is called.
+//
+// The first error returned by a stage cancels the context given to every other stage and is returned by the
+// terminal operation. Cancelling the context given to the terminal operation aborts the pipeline as well.
+type ParallelStream[T any] struct {
+	opts       ParallelOptions
+	chunkCount int
+	// processFunc runs every stage of the pipeline over the chunk with the given index.
+	processFunc func(ctx context.Context, chunk int) ([]T, error)
+}
+
+// Parallel allocates a new ParallelStream over a snapshot of the elements of src.
+func Parallel[T any](src collection.Collection[T], opts ParallelOptions) ParallelStream[T] {
+	items := src.ToSlice()
+	size := opts.chunkSize(len(items))
+	return ParallelStream[T]{
+		opts:       opts,
+		chunkCount: (len(items) + size - 1) / size,
+		processFunc: func(_ context.Context, chunk int) ([]T, error) {
+			return items[chunk*size : min((chunk+1)*size, len(items))], nil
+		},
+	}
+}
+
+// Filter returns a stream of the elements matching predicateFunc (i.e., the ones it returns TRUE for).
+func (p ParallelStream[T]) Filter(predicateFunc ParallelPredicateFunc[T]) ParallelStream[T] {
+	return ParallelStream[T]{
+		opts:       p.opts,
+		chunkCount: p.chunkCount,
+		processFunc: func(ctx context.Context, chunk int) ([]T, error) {
+			items, err := p.processFunc(ctx, chunk)
+			if err != nil {
+				return nil, err
+			}
+			buf := make([]T, 0, len(items))
+			for _, item := range items {
+				if err = ctx.Err(); err != nil {
+					return nil, err
+				}
+				isMatch, errPredicate := predicateFunc(ctx, item)
+				if errPredicate != nil {
+					return nil, errPredicate
+				} else if isMatch {
+					buf = append(buf, item)
+				}
+			}
+			return buf, nil
+		},
+	}
+}
+
+// ParallelMap returns a stream of the results of applying mapFunc to the elements of p.
+func ParallelMap[T, R any](p ParallelStream[T], mapFunc ParallelMapFunc[T, R]) ParallelStream[R] {
+	return ParallelStream[R]{
+		opts:       p.opts,
+		chunkCount: p.chunkCount,
+		processFunc: func(ctx context.Context, chunk int) ([]R, error) {
+			items, err := p.processFunc(ctx, chunk)
+			if err != nil {
+				return nil, err
+			}
+			buf := make([]R, 0, len(items))
+			for _, item := range items {
+				if err = ctx.Err(); err != nil {
+					return nil, err
+				}
+				result, errMap := mapFunc(ctx, item)
+				if errMap != nil {
+					return nil, errMap
+				}
+				buf = append(buf, result)
+			}
+			return buf, nil
+		},
+	}
+}
+
+// run processes every chunk through an executor.ConcurrentExecutor, passing the results of each one to sinkFunc.
+// sinkFunc may be called concurrently. In-flight chunks are always waited for, so sinkFunc is never called once run
+// returns.
+func (p ParallelStream[T]) run(ctx context.Context, sinkFunc func(chunk int, items []T)) error {
+	if p.chunkCount == 0 {
+		return ctx.Err()
+	}
+
+	// stageCtx is cancelled by the first failing chunk or once ctx is done
+	stageCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		errOnce  sync.Once
+		firstErr error
+	)
+	delegates := list.NewSliceList(make([]function.DelegateSafeFuncWithContext[struct{}], 0, p.chunkCount))
+	for i := 0; i < p.chunkCount; i++ {
+		chunk := i
+		delegates.Add(func(_ context.Context, _ struct{}) error {
+			if stageCtx.Err() != nil {
+				// skip chunks scheduled after the pipeline was aborted
+				return nil
+			}
+			items, err := p.processFunc(stageCtx, chunk)
+			if err != nil {
+				// errors are kept here so the executor waits for in-flight chunks, which stop early once
+				// stageCtx is cancelled
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return nil
+			}
+			sinkFunc(chunk, items)
+			return nil
+		})
+	}
+	exec := executor.NewConcurrentExecutor[struct{}](p.opts.maxGoroutines())
+	// the executor stops waiting for its goroutines once its context is done; hence, it gets a context which is
+	// never cancelled, and cancellation only reaches the chunks through stageCtx
+	if err := exec.ExecuteAll(context.WithoutCancel(ctx), struct{}{}, delegates); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return firstErr
+}
+
+// Collect runs the pipeline, returning its results. Results keep the source order unless ParallelOptions.Unordered
+// is set.
+func (p ParallelStream[T]) Collect(ctx context.Context) ([]T, error) {
+	if p.opts.Unordered {
+		var (
+			mu  sync.Mutex
+			buf []T
+		)
+		err := p.run(ctx, func(_ int, items []T) {
+			mu.Lock()
+			buf = append(buf, items...)
+			mu.Unlock()
+		})
+		if err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}
+
+	chunks := make([][]T, p.chunkCount)
+	err := p.run(ctx, func(chunk int, items []T) {
+		chunks[chunk] = items
+	})
+	if err != nil {
+		return nil, err
+	}
+	var buf []T
+	for _, items := range chunks {
+		buf = append(buf, items...)
+	}
+	return buf, nil
+}
+
+// ToList runs the pipeline, returning its results as a new list.SliceList (see Collect).
+func (p ParallelStream[T]) ToList(ctx context.Context) (list.List[T], error) {
+	buf, err := p.Collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return list.NewSliceList(buf), nil
+}
+
+// ForEach runs the pipeline, calling actionFunc with every result from the goroutine processing its chunk. Hence,
+// actionFunc must be safe for concurrent use. The first error returned by actionFunc aborts the pipeline.
+func (p ParallelStream[T]) ForEach(ctx context.Context, actionFunc function.DelegateSafeFuncWithContext[T]) error {
+	return ParallelMap(p, func(ctx context.Context, item T) (struct{}, error) {
+		return struct{}{}, actionFunc(ctx, item)
+	}).run(ctx, func(int, []struct{}) {})
+}
@@ -0,0 +1,165 @@
+package stream_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/collection/stream"
+)
+
+func newRange(n int) *list.SliceList[int] {
+	buf := make([]int, n)
+	for i := range buf {
+		buf[i] = i
+	}
+	return list.NewSliceList(buf)
+}
+
+func TestParallelStream_Collect(t *testing.T) {
+	tests := []struct {
+		name string
+		n    int
+		opts stream.ParallelOptions
+	}{
+		{
+			name: "empty",
+			n:    0,
+		},
+		{
+			name: "default options",
+			n:    1000,
+		},
+		{
+			name: "small chunks",
+			n:    1000,
+			opts: stream.ParallelOptions{MaxGoroutines: 3, ChunkSize: 7},
+		},
+		{
+			name: "unordered",
+			n:    1000,
+			opts: stream.ParallelOptions{MaxGoroutines: 4, ChunkSize: 10, Unordered: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := newRange(tt.n)
+			p := stream.Parallel[int](src, tt.opts).
+				Filter(func(_ context.Context, v int) (bool, error) {
+					return v%3 == 0, nil
+				})
+			results, err := stream.ParallelMap(p, func(_ context.Context, v int) (string, error) {
+				return strconv.Itoa(v * 2), nil
+			}).Collect(context.Background())
+			require.NoError(t, err)
+
+			exp := stream.Map(stream.FromIterable[int](src).Filter(func(v int) bool {
+				return v%3 == 0
+			}), func(v int) string {
+				return strconv.Itoa(v * 2)
+			}).ToSlice()
+			if tt.opts.Unordered {
+				sort.Strings(exp)
+				sort.Strings(results)
+			}
+			assert.Equal(t, exp, results)
+			// source is left untouched
+			assert.Equal(t, tt.n, src.Len())
+		})
+	}
+}
+
+func TestParallelStream_Errors(t *testing.T) {
+	errBadRecord := errors.New("bad record")
+	var processed atomic.Int64
+	p := stream.ParallelMap(stream.Parallel[int](newRange(10000), stream.ParallelOptions{
+		MaxGoroutines: 2,
+		ChunkSize:     10,
+	}), func(ctx context.Context, v int) (int, error) {
+		processed.Add(1)
+		if v == 15 {
+			return 0, errBadRecord
+		}
+		return v, nil
+	})
+
+	results, err := p.Collect(context.Background())
+	assert.ErrorIs(t, err, errBadRecord)
+	assert.Nil(t, results)
+	// remaining chunks are skipped once the pipeline is cancelled
+	assert.Less(t, processed.Load(), int64(10000))
+
+	_, err = p.ToList(context.Background())
+	assert.ErrorIs(t, err, errBadRecord)
+}
+
+func TestParallelStream_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := stream.Parallel[int](newRange(100), stream.ParallelOptions{}).
+		ForEach(ctx, func(context.Context, int) error {
+			return nil
+		})
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = stream.Parallel[int](newRange(0), stream.ParallelOptions{}).Collect(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestParallelStream_ForEach(t *testing.T) {
+	var sum atomic.Int64
+	err := stream.Parallel[int](newRange(101), stream.ParallelOptions{MaxGoroutines: 4}).
+		ForEach(context.Background(), func(_ context.Context, v int) error {
+			sum.Add(int64(v))
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5050), sum.Load())
+
+	errStop := errors.New("stop")
+	err = stream.Parallel[int](newRange(101), stream.ParallelOptions{MaxGoroutines: 4}).
+		ForEach(context.Background(), func(_ context.Context, v int) error {
+			if v == 50 {
+				return errStop
+			}
+			return nil
+		})
+	assert.ErrorIs(t, err, errStop)
+}
+
+func TestParallelStream_CancelWhileRunning(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	var (
+		isReturned atomic.Bool
+		lateSinks  atomic.Int64
+	)
+	buf, err := stream.ParallelMap(stream.Parallel[int](newRange(64), stream.ParallelOptions{ChunkSize: 1}),
+		func(ctx context.Context, v int) (int, error) {
+			select {
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			case <-time.After(time.Duration(v%4) * 10 * time.Millisecond):
+			}
+			if isReturned.Load() {
+				lateSinks.Add(1)
+			}
+			return v, nil
+		}).Collect(ctx)
+	isReturned.Store(true)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, buf)
+
+	// give misbehaving goroutines a chance to run after Collect returned
+	time.Sleep(50 * time.Millisecond)
+	assert.Zero(t, lateSinks.Load())
+}
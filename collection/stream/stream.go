@@ -0,0 +1,287 @@
+// Package stream provides lazy, pull-based pipelines over collection.Iterable sources (e.g., list.SliceList,
+// set.HashSet) or generated, potentially infinite, sequences.
+//
+// A Stream is composed of a source, zero or more intermediate operations (e.g., Filter, Map, Limit) and a terminal
+// operation (e.g., Count, Reduce, ToList). Intermediate operations only describe the pipeline; elements are pulled
+// from the source one by one, and only as needed, once a terminal operation runs. Hence, a Stream can be consumed
+// only once.
+package stream
+
+import (
+	"sort"
+
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/function"
+)
+
+// PullFunc is the routine streams are built on: every call returns the next element of a sequence, or false once
+// the sequence is exhausted.
+type PullFunc[T any] func() (T, bool)
+
+// Stream is a lazy sequence of elements supporting functional-style operations.
+// Operations changing the element type (e.g., Map, FlatMap) or requiring comparable elements (e.g., Distinct) are
+// package functions, as Go methods cannot declare type parameters.
+//
+// Zero-value is an empty stream.
+type Stream[T any] struct {
+	pull PullFunc[T]
+}
+
+// New allocates a new Stream pulling elements from the given function.
+func New[T any](pullFunc PullFunc[T]) Stream[T] {
+	if pullFunc == nil {
+		panic("pullFunc cannot be nil")
+	}
+	return Stream[T]{pull: pullFunc}
+}
+
+// Of allocates a new Stream over the given items.
+func Of[T any](items ...T) Stream[T] {
+	index := 0
+	return New(func() (T, bool) {
+		if index >= len(items) {
+			var zeroVal T
+			return zeroVal, false
+		}
+		index++
+		return items[index-1], true
+	})
+}
+
+// FromIterable allocates a new Stream over the elements of src. The collection.Iterator is only created once the
+// stream is consumed.
+func FromIterable[T any](src collection.Iterable[T]) Stream[T] {
+	var iterator collection.Iterator[T]
+	return New(func() (T, bool) {
+		if iterator == nil {
+			iterator = src.NewIterator()
+		}
+		return pullIterator[T](iterator)
+	})
+}
+
+// FromIterator allocates a new Stream over the remaining elements of iterator.
+func FromIterator[T any](iterator collection.ForwardIterator[T]) Stream[T] {
+	return New(func() (T, bool) {
+		return pullIterator(iterator)
+	})
+}
+
+func pullIterator[T any](iterator collection.ForwardIterator[T]) (T, bool) {
+	if !iterator.HasNext() {
+		var zeroVal T
+		return zeroVal, false
+	}
+	return iterator.Next(), true
+}
+
+// Generate allocates a new infinite Stream whose elements are returned by supplierFunc.
+// Use Limit or TakeWhile to bound it.
+func Generate[T any](supplierFunc func() T) Stream[T] {
+	return New(func() (T, bool) {
+		return supplierFunc(), true
+	})
+}
+
+// Iterate allocates a new infinite Stream made of seed, nextFunc(seed), nextFunc(nextFunc(seed)) and so on.
+// Use Limit or TakeWhile to bound it.
+func Iterate[T any](seed T, nextFunc function.DelegateFunc[T, T]) Stream[T] {
+	current, isStarted := seed, false
+	return New(func() (T, bool) {
+		if isStarted {
+			current = nextFunc(current)
+		}
+		isStarted = true
+		return current, true
+	})
+}
+
+// Next pulls the next element of this stream. Returns false once the stream is exhausted.
+func (s Stream[T]) Next() (T, bool) {
+	if s.pull == nil {
+		var zeroVal T
+		return zeroVal, false
+	}
+	return s.pull()
+}
+
+// Iterator returns a collection.ForwardIterator over the remaining elements of this stream.
+func (s Stream[T]) Iterator() collection.ForwardIterator[T] {
+	return &streamIterator[T]{stream: s}
+}
+
+// Filter returns a stream of the elements matching predicateFunc (i.e., the ones it returns TRUE for).
+func (s Stream[T]) Filter(predicateFunc function.PredicateFunc[T]) Stream[T] {
+	return New(func() (T, bool) {
+		for {
+			v, ok := s.Next()
+			if !ok || predicateFunc(v) {
+				return v, ok
+			}
+		}
+	})
+}
+
+// Peek returns a stream of the same elements, calling actionFunc with each one as it is pulled (e.g., for logging).
+func (s Stream[T]) Peek(actionFunc func(v T)) Stream[T] {
+	return New(func() (T, bool) {
+		v, ok := s.Next()
+		if ok {
+			actionFunc(v)
+		}
+		return v, ok
+	})
+}
+
+// Limit returns a stream of, at most, the first n elements. Elements past n are never pulled.
+func (s Stream[T]) Limit(n int) Stream[T] {
+	pulled := 0
+	return New(func() (T, bool) {
+		if pulled >= n {
+			var zeroVal T
+			return zeroVal, false
+		}
+		pulled++
+		return s.Next()
+	})
+}
+
+// Skip returns a stream discarding the first n elements.
+func (s Stream[T]) Skip(n int) Stream[T] {
+	return New(func() (T, bool) {
+		for ; n > 0; n-- {
+			if _, ok := s.Next(); !ok {
+				break
+			}
+		}
+		return s.Next()
+	})
+}
+
+// TakeWhile returns a stream of the leading elements matching predicateFunc, ending at the first one which does not.
+func (s Stream[T]) TakeWhile(predicateFunc function.PredicateFunc[T]) Stream[T] {
+	isDone := false
+	return New(func() (T, bool) {
+		var zeroVal T
+		if isDone {
+			return zeroVal, false
+		}
+		v, ok := s.Next()
+		if !ok || !predicateFunc(v) {
+			isDone = true
+			return zeroVal, false
+		}
+		return v, true
+	})
+}
+
+// DropWhile returns a stream discarding the leading elements matching predicateFunc, starting at the first one
+// which does not.
+func (s Stream[T]) DropWhile(predicateFunc function.PredicateFunc[T]) Stream[T] {
+	isDropped := false
+	return New(func() (T, bool) {
+		if isDropped {
+			return s.Next()
+		}
+		isDropped = true
+		for {
+			v, ok := s.Next()
+			if !ok || !predicateFunc(v) {
+				return v, ok
+			}
+		}
+	})
+}
+
+// Sorted returns a stream of the elements sorted by comparator. Sorting is stable and, unlike other intermediate
+// operations, it needs to pull every element from the source (once the first element is requested); thus, it
+// must not be used over infinite streams.
+func (s Stream[T]) Sorted(comparator collection.ComparatorFunc[T]) Stream[T] {
+	var sorted Stream[T]
+	return New(func() (T, bool) {
+		if sorted.pull == nil {
+			buf := s.ToSlice()
+			sort.SliceStable(buf, func(i, j int) bool {
+				return comparator(buf[i], buf[j]) < 0
+			})
+			sorted = Of(buf...)
+		}
+		return sorted.Next()
+	})
+}
+
+// Map returns a stream of the results of applying mapFunc to the elements of s.
+func Map[T, R any](s Stream[T], mapFunc function.DelegateFunc[T, R]) Stream[R] {
+	return New(func() (R, bool) {
+		v, ok := s.Next()
+		if !ok {
+			var zeroVal R
+			return zeroVal, false
+		}
+		return mapFunc(v), true
+	})
+}
+
+// FlatMap returns a stream concatenating the streams returned by mapFunc for every element of s.
+func FlatMap[T, R any](s Stream[T], mapFunc function.DelegateFunc[T, Stream[R]]) Stream[R] {
+	var current Stream[R]
+	return New(func() (R, bool) {
+		for {
+			if v, ok := current.Next(); ok {
+				return v, true
+			}
+			v, ok := s.Next()
+			if !ok {
+				var zeroVal R
+				return zeroVal, false
+			}
+			current = mapFunc(v)
+		}
+	})
+}
+
+// Distinct returns a stream of the elements of s, dropping the ones already seen.
+func Distinct[T comparable](s Stream[T]) Stream[T] {
+	seen := make(map[T]struct{})
+	return s.Filter(func(v T) bool {
+		if _, ok := seen[v]; ok {
+			return false
+		}
+		seen[v] = struct{}{}
+		return true
+	})
+}
+
+// Concat returns a stream of the elements of every given stream, in order.
+func Concat[T any](streams ...Stream[T]) Stream[T] {
+	return FlatMap(Of(streams...), func(s Stream[T]) Stream[T] {
+		return s
+	})
+}
+
+// streamIterator is the implementation of collection.ForwardIterator over a Stream, pulling one element ahead.
+type streamIterator[T any] struct {
+	stream   Stream[T]
+	next     T
+	hasNext  bool
+	isPrimed bool
+}
+
+var _ collection.ForwardIterator[string] = &streamIterator[string]{}
+
+func (i *streamIterator[T]) HasNext() bool {
+	if !i.isPrimed {
+		i.next, i.hasNext = i.stream.Next()
+		i.isPrimed = true
+	}
+	return i.hasNext
+}
+
+func (i *streamIterator[T]) Next() T {
+	if !i.HasNext() {
+		var zeroVal T
+		return zeroVal
+	}
+	i.isPrimed = false
+	return i.next
+}
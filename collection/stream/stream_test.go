@@ -0,0 +1,192 @@
+package stream_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/collection/stream"
+)
+
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+func TestStream_Intermediate(t *testing.T) {
+	tests := []struct {
+		name       string
+		streamFunc func() stream.Stream[int]
+		exp        []int
+	}{
+		{
+			name: "zero value",
+			streamFunc: func() stream.Stream[int] {
+				return stream.Stream[int]{}.Filter(isEven)
+			},
+			exp: nil,
+		},
+		{
+			name: "filter",
+			streamFunc: func() stream.Stream[int] {
+				return stream.Of(1, 2, 3, 4, 5, 6).Filter(isEven)
+			},
+			exp: []int{2, 4, 6},
+		},
+		{
+			name: "map",
+			streamFunc: func() stream.Stream[int] {
+				return stream.Map(stream.Of("1", "22", "333"), func(s string) int {
+					return len(s)
+				})
+			},
+			exp: []int{1, 2, 3},
+		},
+		{
+			name: "flat map",
+			streamFunc: func() stream.Stream[int] {
+				return stream.FlatMap(stream.Of(0, 2, 1), func(n int) stream.Stream[int] {
+					return stream.Iterate(n, func(v int) int { return v }).Limit(n)
+				})
+			},
+			exp: []int{2, 2, 1},
+		},
+		{
+			name: "distinct",
+			streamFunc: func() stream.Stream[int] {
+				return stream.Distinct(stream.Of(3, 1, 3, 2, 1))
+			},
+			exp: []int{3, 1, 2},
+		},
+		{
+			name: "sorted is stable",
+			streamFunc: func() stream.Stream[int] {
+				return stream.Of(13, 2, 11, 4, 3).Sorted(func(a, b int) int {
+					return a%10 - b%10
+				})
+			},
+			exp: []int{11, 2, 13, 3, 4},
+		},
+		{
+			name: "skip and limit",
+			streamFunc: func() stream.Stream[int] {
+				return stream.Of(1, 2, 3, 4, 5).Skip(1).Limit(3)
+			},
+			exp: []int{2, 3, 4},
+		},
+		{
+			name: "skip past end",
+			streamFunc: func() stream.Stream[int] {
+				return stream.Of(1, 2).Skip(5)
+			},
+			exp: nil,
+		},
+		{
+			name: "take while",
+			streamFunc: func() stream.Stream[int] {
+				return stream.Of(2, 4, 5, 6).TakeWhile(isEven)
+			},
+			exp: []int{2, 4},
+		},
+		{
+			name: "drop while",
+			streamFunc: func() stream.Stream[int] {
+				return stream.Of(2, 4, 5, 6).DropWhile(isEven)
+			},
+			exp: []int{5, 6},
+		},
+		{
+			name: "infinite source",
+			streamFunc: func() stream.Stream[int] {
+				return stream.Iterate(1, func(v int) int { return v + 1 }).Filter(isEven).Limit(3)
+			},
+			exp: []int{2, 4, 6},
+		},
+		{
+			name: "concat",
+			streamFunc: func() stream.Stream[int] {
+				return stream.Concat(stream.Of(1), stream.Stream[int]{}, stream.Of(2, 3))
+			},
+			exp: []int{1, 2, 3},
+		},
+		{
+			name: "iterable",
+			streamFunc: func() stream.Stream[int] {
+				return stream.FromIterable[int](list.NewDoublyLinkedListFromSlice([]int{3, 2, 1}))
+			},
+			exp: []int{3, 2, 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, tt.streamFunc().ToSlice())
+		})
+	}
+}
+
+func TestStream_Laziness(t *testing.T) {
+	var pulled []int
+	s := stream.Iterate(1, func(v int) int { return v + 1 }).
+		Peek(func(v int) {
+			pulled = append(pulled, v)
+		}).
+		Filter(isEven)
+	assert.Empty(t, pulled)
+
+	first, ok := s.FindFirst()
+	assert.True(t, ok)
+	assert.Equal(t, 2, first)
+	assert.Equal(t, []int{1, 2}, pulled)
+	assert.True(t, s.AnyMatch(func(v int) bool { return v > 5 }))
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, pulled)
+}
+
+func TestStream_Terminal(t *testing.T) {
+	newStream := func() stream.Stream[int] {
+		return stream.FromIterable[int](list.NewSliceList([]int{4, 1, 3, 1, 5}))
+	}
+	assert.Equal(t, 5, newStream().Count())
+	assert.Equal(t, 14, newStream().Reduce(0, func(a, b int) int { return a + b }))
+	assert.Equal(t, "41315", stream.Fold(newStream(), "", func(acc string, v int) string {
+		return acc + strconv.Itoa(v)
+	}))
+	assert.True(t, newStream().AnyMatch(isEven))
+	assert.False(t, newStream().AllMatch(isEven))
+	assert.True(t, newStream().AllMatch(func(v int) bool { return v > 0 }))
+	assert.True(t, newStream().NoneMatch(func(v int) bool { return v > 5 }))
+	assert.True(t, stream.Of[int]().AllMatch(isEven))
+
+	minVal, ok := newStream().Min(collection.Compare[int])
+	assert.True(t, ok)
+	assert.Equal(t, 1, minVal)
+	maxVal, ok := newStream().Max(collection.Compare[int])
+	assert.True(t, ok)
+	assert.Equal(t, 5, maxVal)
+	_, ok = stream.Of[int]().Max(collection.Compare[int])
+	assert.False(t, ok)
+
+	assert.Equal(t, []int{4, 1, 3, 1, 5}, newStream().ToList().ToSlice())
+	assert.Equal(t, 4, stream.ToSet(newStream()).Len())
+	byParity := stream.ToMap(newStream(), isEven, func(v int) int { return v })
+	assert.Equal(t, 5, byParity.GetWithFallback(false, 0))
+	groups := stream.GroupBy(newStream(), isEven)
+	oddGroup, _ := groups.Get(false)
+	assert.Equal(t, []int{1, 3, 1, 5}, oddGroup.ToSlice())
+
+	var buf []string
+	stream.Map(newStream(), strconv.Itoa).ForEach(func(v string) {
+		buf = append(buf, v)
+	})
+	assert.Equal(t, "4,1,3,1,5", strings.Join(buf, ","))
+
+	iterator := newStream().Limit(2).Iterator()
+	assert.True(t, iterator.HasNext())
+	assert.True(t, iterator.HasNext())
+	assert.Equal(t, 4, iterator.Next())
+	assert.Equal(t, 1, iterator.Next())
+	assert.False(t, iterator.HasNext())
+}
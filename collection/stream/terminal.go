@@ -0,0 +1,154 @@
+package stream
+
+import (
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/collection/maps"
+	"github.com/neutrinocorp/nolan/collection/set"
+	"github.com/neutrinocorp/nolan/function"
+)
+
+// ForEach pulls every element of this stream, calling actionFunc with each one.
+func (s Stream[T]) ForEach(actionFunc func(v T)) {
+	for v, ok := s.Next(); ok; v, ok = s.Next() {
+		actionFunc(v)
+	}
+}
+
+// Count pulls every element of this stream, returning how many there were.
+func (s Stream[T]) Count() int {
+	count := 0
+	for _, ok := s.Next(); ok; _, ok = s.Next() {
+		count++
+	}
+	return count
+}
+
+// AnyMatch returns true if any element matches predicateFunc. Stops pulling at the first match.
+func (s Stream[T]) AnyMatch(predicateFunc function.PredicateFunc[T]) bool {
+	_, found := s.Filter(predicateFunc).Next()
+	return found
+}
+
+// AllMatch returns true if every element matches predicateFunc (or if this stream is empty). Stops pulling at the
+// first mismatch.
+func (s Stream[T]) AllMatch(predicateFunc function.PredicateFunc[T]) bool {
+	return !s.AnyMatch(func(v T) bool {
+		return !predicateFunc(v)
+	})
+}
+
+// NoneMatch returns true if no element matches predicateFunc (or if this stream is empty). Stops pulling at the
+// first match.
+func (s Stream[T]) NoneMatch(predicateFunc function.PredicateFunc[T]) bool {
+	return !s.AnyMatch(predicateFunc)
+}
+
+// FindFirst returns the first element of this stream. Returns false if the stream is empty.
+func (s Stream[T]) FindFirst() (T, bool) {
+	return s.Next()
+}
+
+// Reduce combines every element with an accumulated value, starting from identity, and returns the final result.
+//
+// e.g. Of(1, 2, 3).Reduce(0, func(a, b int) int { return a + b }) returns 6.
+func (s Stream[T]) Reduce(identity T, reduceFunc function.DelegateBiFunc[T, T, T]) T {
+	return Fold[T, T](s, identity, reduceFunc)
+}
+
+// Min returns the smallest element of this stream according to comparator. If several elements are the smallest,
+// the first one is returned. Returns false if the stream is empty.
+func (s Stream[T]) Min(comparator collection.ComparatorFunc[T]) (T, bool) {
+	return s.pickBy(func(candidate, best T) bool {
+		return comparator(candidate, best) < 0
+	})
+}
+
+// Max returns the greatest element of this stream according to comparator. If several elements are the greatest,
+// the first one is returned. Returns false if the stream is empty.
+func (s Stream[T]) Max(comparator collection.ComparatorFunc[T]) (T, bool) {
+	return s.pickBy(func(candidate, best T) bool {
+		return comparator(candidate, best) > 0
+	})
+}
+
+func (s Stream[T]) pickBy(isBetterFunc func(candidate, best T) bool) (T, bool) {
+	best, ok := s.Next()
+	if !ok {
+		return best, false
+	}
+	for v, hasNext := s.Next(); hasNext; v, hasNext = s.Next() {
+		if isBetterFunc(v, best) {
+			best = v
+		}
+	}
+	return best, true
+}
+
+// ToSlice pulls every element of this stream into a slice. Returns nil if the stream is empty.
+func (s Stream[T]) ToSlice() []T {
+	var buf []T
+	for v, ok := s.Next(); ok; v, ok = s.Next() {
+		buf = append(buf, v)
+	}
+	return buf
+}
+
+// ToList pulls every element of this stream into a new list.SliceList.
+func (s Stream[T]) ToList() list.List[T] {
+	return list.NewSliceList(s.ToSlice())
+}
+
+// CollectInto pulls every element of this stream into dst (e.g., a set.LinkedHashSet or a queue), returning it.
+func (s Stream[T]) CollectInto(dst collection.Collection[T]) collection.Collection[T] {
+	s.ForEach(func(v T) {
+		dst.Add(v)
+	})
+	return dst
+}
+
+// Fold combines every element of s with an accumulated value of a different type, starting from identity, and
+// returns the final result.
+func Fold[T, R any](s Stream[T], identity R, foldFunc function.DelegateBiFunc[R, T, R]) R {
+	acc := identity
+	for v, ok := s.Next(); ok; v, ok = s.Next() {
+		acc = foldFunc(acc, v)
+	}
+	return acc
+}
+
+// ToSet pulls every element of s into a new set.HashSet.
+func ToSet[T comparable](s Stream[T]) set.Set[T] {
+	buf := set.HashSet[T]{}
+	s.ForEach(func(v T) {
+		buf.Add(v)
+	})
+	return buf
+}
+
+// ToMap pulls every element of s into a new maps.HashMap, using keyFunc and valueFunc to build its entries.
+// If several elements share a key, the last one wins.
+func ToMap[T any, K comparable, V any](s Stream[T], keyFunc function.DelegateFunc[T, K],
+	valueFunc function.DelegateFunc[T, V]) maps.Map[K, V] {
+	buf := maps.HashMap[K, V]{}
+	s.ForEach(func(v T) {
+		buf.Put(keyFunc(v), valueFunc(v))
+	})
+	return buf
+}
+
+// GroupBy pulls every element of s into a new maps.HashMap, grouping elements sharing the key returned by keyFunc
+// in a list.List (in stream order).
+func GroupBy[T any, K comparable](s Stream[T], keyFunc function.DelegateFunc[T, K]) maps.Map[K, list.List[T]] {
+	buf := maps.HashMap[K, list.List[T]]{}
+	s.ForEach(func(v T) {
+		key := keyFunc(v)
+		group, ok := buf[key]
+		if !ok {
+			group = list.NewSliceList[T](nil)
+			buf[key] = group
+		}
+		group.Add(v)
+	})
+	return buf
+}
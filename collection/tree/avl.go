@@ -1,3 +1,354 @@
 package tree
 
-// TODO: IMPLEMENT ME
+import (
+	"github.com/neutrinocorp/nolan/collection"
+)
+
+// avlNode is a structure used to hold a key, pointer references to its children, the height of the subtree
+// rooted at this node and the number of keys within it (order-statistic augmentation).
+type avlNode[T any] struct {
+	left   *avlNode[T]
+	right  *avlNode[T]
+	key    T
+	height int
+	size   int
+}
+
+// AVLTree is a self-balancing binary search tree of unique keys where the heights of the two child subtrees of any
+// node differ by at most one.
+//
+// Every node keeps the size of its subtree, allowing order-statistic queries: Rank returns how many keys are
+// smaller than a given key and Select returns the i-th smallest key, both in O(log n) time.
+// Add, Remove and Contains take O(log n) time as well.
+//
+// Keys are sorted using a collection.ComparatorFunc; adding a key which compares equal to an existing one is a no-op.
+type AVLTree[T any] struct {
+	root       *avlNode[T]
+	comparator collection.ComparatorFunc[T]
+}
+
+var _ collection.Collection[string] = &AVLTree[string]{}
+
+// NewAVLTree allocates a new AVLTree instance sorting keys with the given comparator.
+func NewAVLTree[T any](comparator collection.ComparatorFunc[T]) *AVLTree[T] {
+	if comparator == nil {
+		panic("comparator cannot be nil")
+	}
+	return &AVLTree[T]{
+		comparator: comparator,
+	}
+}
+
+// NewOrderedAVLTree allocates a new AVLTree instance sorting keys by their natural order (see collection.Compare).
+func NewOrderedAVLTree[T collection.Ordered]() *AVLTree[T] {
+	return NewAVLTree[T](collection.Compare[T])
+}
+
+func heightOf[T any](node *avlNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+func sizeOf[T any](node *avlNode[T]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+func (n *avlNode[T]) update() {
+	n.height = max(heightOf(n.left), heightOf(n.right)) + 1
+	n.size = sizeOf(n.left) + sizeOf(n.right) + 1
+}
+
+func (n *avlNode[T]) balanceFactor() int {
+	return heightOf(n.left) - heightOf(n.right)
+}
+
+func rotateAVLLeft[T any](node *avlNode[T]) *avlNode[T] {
+	pivot := node.right
+	node.right = pivot.left
+	pivot.left = node
+	node.update()
+	pivot.update()
+	return pivot
+}
+
+func rotateAVLRight[T any](node *avlNode[T]) *avlNode[T] {
+	pivot := node.left
+	node.left = pivot.right
+	pivot.right = node
+	node.update()
+	pivot.update()
+	return pivot
+}
+
+// rebalance updates node's metadata and restores the AVL invariant, returning the new subtree root.
+func rebalance[T any](node *avlNode[T]) *avlNode[T] {
+	node.update()
+	switch factor := node.balanceFactor(); {
+	case factor > 1:
+		if node.left.balanceFactor() < 0 {
+			node.left = rotateAVLLeft(node.left)
+		}
+		return rotateAVLRight(node)
+	case factor < -1:
+		if node.right.balanceFactor() > 0 {
+			node.right = rotateAVLRight(node.right)
+		}
+		return rotateAVLLeft(node)
+	}
+	return node
+}
+
+func (t *AVLTree[T]) insert(node *avlNode[T], v T) (*avlNode[T], bool) {
+	if node == nil {
+		return &avlNode[T]{key: v, height: 1, size: 1}, true
+	}
+
+	var wasAdded bool
+	cmp := t.comparator(v, node.key)
+	if cmp == 0 {
+		return node, false
+	} else if cmp < 0 {
+		node.left, wasAdded = t.insert(node.left, v)
+	} else {
+		node.right, wasAdded = t.insert(node.right, v)
+	}
+	if !wasAdded {
+		return node, false
+	}
+	return rebalance(node), true
+}
+
+func (t *AVLTree[T]) delete(node *avlNode[T], v T) (*avlNode[T], bool) {
+	if node == nil {
+		return nil, false
+	}
+
+	var wasRemoved bool
+	cmp := t.comparator(v, node.key)
+	switch {
+	case cmp < 0:
+		node.left, wasRemoved = t.delete(node.left, v)
+	case cmp > 0:
+		node.right, wasRemoved = t.delete(node.right, v)
+	default:
+		if node.left == nil {
+			return node.right, true
+		} else if node.right == nil {
+			return node.left, true
+		}
+		successorNode := node.right
+		for successorNode.left != nil {
+			successorNode = successorNode.left
+		}
+		node.key = successorNode.key
+		node.right, _ = t.delete(node.right, successorNode.key)
+		wasRemoved = true
+	}
+	if !wasRemoved {
+		return node, false
+	}
+	return rebalance(node), true
+}
+
+// NewIterator returns an iterator over the keys of this tree in ascending (Next) and descending (Previous) order.
+// Every step takes O(log n) time.
+func (t *AVLTree[T]) NewIterator() collection.Iterator[T] {
+	return &avlIterator[T]{
+		tree:                 t,
+		currentForwardIndex:  0,
+		currentBackwardIndex: t.Len() - 1,
+	}
+}
+
+// Add adds an element into this collection.
+// Returns false if an equal element was already present.
+func (t *AVLTree[T]) Add(v T) bool {
+	var wasAdded bool
+	t.root, wasAdded = t.insert(t.root, v)
+	return wasAdded
+}
+
+// AddAll adds all the elements into this collection.
+func (t *AVLTree[T]) AddAll(src collection.Collection[T]) bool {
+	wasMod := false
+	src.ForEach(func(a T) bool {
+		if t.Add(a) {
+			wasMod = true
+		}
+		return false
+	})
+	return wasMod
+}
+
+// AddSlice adds all the elements in the specified slice (variadic) to this collection.
+func (t *AVLTree[T]) AddSlice(items ...T) bool {
+	wasMod := false
+	for _, item := range items {
+		if t.Add(item) {
+			wasMod = true
+		}
+	}
+	return wasMod
+}
+
+// Remove removes the specified element from this collection if it is present.
+func (t *AVLTree[T]) Remove(v T) bool {
+	var wasRemoved bool
+	t.root, wasRemoved = t.delete(t.root, v)
+	return wasRemoved
+}
+
+// Contains returns true if this collection contains the specified element.
+func (t *AVLTree[T]) Contains(v T) bool {
+	currentNode := t.root
+	for currentNode != nil {
+		cmp := t.comparator(v, currentNode.key)
+		if cmp == 0 {
+			return true
+		} else if cmp < 0 {
+			currentNode = currentNode.left
+			continue
+		}
+		currentNode = currentNode.right
+	}
+	return false
+}
+
+// Clear removes all the elements from this collection.
+func (t *AVLTree[T]) Clear() {
+	t.root = nil
+}
+
+// Len returns the number of elements in this collection.
+func (t *AVLTree[T]) Len() int {
+	return sizeOf(t.root)
+}
+
+// IsEmpty returns true if this collection contains no elements.
+func (t *AVLTree[T]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// ToSlice returns all the elements from this collection as a slice of T, sorted in ascending order.
+func (t *AVLTree[T]) ToSlice() []T {
+	if t.root == nil {
+		return nil
+	}
+
+	buf := make([]T, 0, t.Len())
+	t.ForEach(func(a T) bool {
+		buf = append(buf, a)
+		return false
+	})
+	return buf
+}
+
+// ForEach traverses through all the elements from this collection in ascending order.
+// Use predicate's return value to indicate a break of the iteration, TRUE meaning a break.
+func (t *AVLTree[T]) ForEach(predicateFunc collection.IterablePredicateFunc[T]) {
+	stack := make([]*avlNode[T], 0, heightOf(t.root))
+	currentNode := t.root
+	for currentNode != nil || len(stack) > 0 {
+		for currentNode != nil {
+			stack = append(stack, currentNode)
+			currentNode = currentNode.left
+		}
+		currentNode = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if breakIter := predicateFunc(currentNode.key); breakIter {
+			break
+		}
+		currentNode = currentNode.right
+	}
+}
+
+// Rank returns the number of elements in this collection strictly smaller than v.
+// If v is present, this is also its zero-based index in sorted order.
+func (t *AVLTree[T]) Rank(v T) int {
+	rank := 0
+	currentNode := t.root
+	for currentNode != nil {
+		cmp := t.comparator(v, currentNode.key)
+		if cmp <= 0 {
+			if cmp == 0 {
+				return rank + sizeOf(currentNode.left)
+			}
+			currentNode = currentNode.left
+			continue
+		}
+		rank += sizeOf(currentNode.left) + 1
+		currentNode = currentNode.right
+	}
+	return rank
+}
+
+// Select returns the element at the specified zero-based position in sorted order (i.e., the (index+1)-th smallest
+// element). Returns false if index is out of bounds.
+func (t *AVLTree[T]) Select(index int) (T, bool) {
+	if index < 0 || index >= t.Len() {
+		var zeroVal T
+		return zeroVal, false
+	}
+
+	currentNode := t.root
+	for {
+		leftSize := sizeOf(currentNode.left)
+		if index == leftSize {
+			return currentNode.key, true
+		} else if index < leftSize {
+			currentNode = currentNode.left
+			continue
+		}
+		index -= leftSize + 1
+		currentNode = currentNode.right
+	}
+}
+
+// Min returns the smallest element from this collection.
+func (t *AVLTree[T]) Min() (T, bool) {
+	return t.Select(0)
+}
+
+// Max returns the greatest element from this collection.
+func (t *AVLTree[T]) Max() (T, bool) {
+	return t.Select(t.Len() - 1)
+}
+
+// avlIterator is the implementation of collection.Iterator traversing an AVLTree by rank.
+type avlIterator[T any] struct {
+	tree                 *AVLTree[T]
+	currentForwardIndex  int
+	currentBackwardIndex int
+}
+
+var _ collection.Iterator[string] = &avlIterator[string]{}
+
+func (i *avlIterator[T]) HasNext() bool {
+	return i.currentForwardIndex <= i.tree.Len()-1
+}
+
+func (i *avlIterator[T]) Next() T {
+	key, _ := i.tree.Select(i.currentForwardIndex)
+	i.currentForwardIndex++
+	return key
+}
+
+func (i *avlIterator[T]) HasPrevious() bool {
+	return i.currentBackwardIndex >= 0
+}
+
+func (i *avlIterator[T]) Previous() T {
+	key, _ := i.tree.Select(i.currentBackwardIndex)
+	i.currentBackwardIndex--
+	return key
+}
+
+func (i *avlIterator[T]) Reset() {
+	i.currentForwardIndex = 0
+	i.currentBackwardIndex = i.tree.Len() - 1
+}
@@ -0,0 +1,101 @@
+package tree_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutrinocorp/nolan/collection/tree"
+)
+
+func TestAVLTree_AddRemove(t *testing.T) {
+	tr := tree.NewOrderedAVLTree[int]()
+	ref := map[int]struct{}{}
+	rnd := rand.New(rand.NewSource(7))
+	for i := 0; i < 5000; i++ {
+		key := rnd.Intn(300)
+		_, exists := ref[key]
+		if rnd.Intn(3) > 0 {
+			assert.Equal(t, !exists, tr.Add(key))
+			ref[key] = struct{}{}
+			continue
+		}
+		assert.Equal(t, exists, tr.Remove(key))
+		delete(ref, key)
+	}
+
+	exp := make([]int, 0, len(ref))
+	for key := range ref {
+		exp = append(exp, key)
+	}
+	sort.Ints(exp)
+	require.Equal(t, len(exp), tr.Len())
+	assert.Equal(t, exp, tr.ToSlice())
+	for _, key := range exp {
+		assert.True(t, tr.Contains(key))
+	}
+}
+
+func TestAVLTree_RankSelect(t *testing.T) {
+	tr := tree.NewOrderedAVLTree[int]()
+	tr.AddSlice(50, 10, 40, 20, 30)
+
+	tests := []struct {
+		name    string
+		in      int
+		expRank int
+	}{
+		{name: "smallest", in: 10, expRank: 0},
+		{name: "present", in: 30, expRank: 2},
+		{name: "greatest", in: 50, expRank: 4},
+		{name: "absent below", in: 5, expRank: 0},
+		{name: "absent between", in: 35, expRank: 3},
+		{name: "absent above", in: 99, expRank: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expRank, tr.Rank(tt.in))
+		})
+	}
+
+	for i, exp := range []int{10, 20, 30, 40, 50} {
+		out, ok := tr.Select(i)
+		assert.True(t, ok)
+		assert.Equal(t, exp, out)
+	}
+	_, ok := tr.Select(5)
+	assert.False(t, ok)
+	_, ok = tr.Select(-1)
+	assert.False(t, ok)
+}
+
+func TestAVLTree_Iterator(t *testing.T) {
+	tr := tree.NewAVLTree[string](func(a, b string) int {
+		// reverse lexicographical order
+		if a > b {
+			return -1
+		} else if a < b {
+			return 1
+		}
+		return 0
+	})
+	tr.AddSlice("a", "c", "b", "d")
+	exp := []string{"d", "c", "b", "a"}
+
+	iter := tr.NewIterator()
+	i := 0
+	for iter.HasNext() {
+		assert.Equal(t, exp[i], iter.Next())
+		i++
+	}
+	assert.Equal(t, len(exp), i)
+	i = len(exp) - 1
+	for iter.HasPrevious() {
+		assert.Equal(t, exp[i], iter.Previous())
+		i--
+	}
+	assert.Equal(t, -1, i)
+}
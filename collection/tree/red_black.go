@@ -1,3 +1,551 @@
 package tree
 
-// TODO: IMPLEMENT ME
+import (
+	"github.com/neutrinocorp/nolan/collection"
+)
+
+type redBlackColor bool
+
+const (
+	red   redBlackColor = false
+	black redBlackColor = true
+)
+
+// redBlackNode is a structure used to hold a key-value pair, its color and pointer references to its parent and
+// children nodes.
+type redBlackNode[K, V any] struct {
+	parent *redBlackNode[K, V]
+	left   *redBlackNode[K, V]
+	right  *redBlackNode[K, V]
+	key    K
+	value  V
+	color  redBlackColor
+}
+
+// RedBlackTree is a self-balancing binary search tree storing key-value pairs sorted by their keys.
+// Every node is either red or black and the tree keeps the following invariants to guarantee a height of
+// O(log n):
+//
+//   - The root and every leaf (nil) are black.
+//   - A red node never has a red child.
+//   - Every path from a node to its descendant leaves contains the same number of black nodes.
+//
+// Insertion, removal, lookup and navigation routines (e.g., Floor, Ceiling) take O(log n) time.
+// Keys are sorted using a collection.ComparatorFunc.
+type RedBlackTree[K, V any] struct {
+	root       *redBlackNode[K, V]
+	comparator collection.ComparatorFunc[K]
+	len        int
+}
+
+var _ collection.Iterable[string] = &RedBlackTree[string, int]{}
+
+// NewRedBlackTree allocates a new RedBlackTree instance sorting keys with the given comparator.
+func NewRedBlackTree[K, V any](comparator collection.ComparatorFunc[K]) *RedBlackTree[K, V] {
+	if comparator == nil {
+		panic("comparator cannot be nil")
+	}
+	return &RedBlackTree[K, V]{
+		comparator: comparator,
+	}
+}
+
+// NewOrderedRedBlackTree allocates a new RedBlackTree instance sorting keys by their natural order
+// (see collection.Compare).
+func NewOrderedRedBlackTree[K collection.Ordered, V any]() *RedBlackTree[K, V] {
+	return NewRedBlackTree[K, V](collection.Compare[K])
+}
+
+func colorOf[K, V any](node *redBlackNode[K, V]) redBlackColor {
+	if node == nil {
+		return black
+	}
+	return node.color
+}
+
+func (t *RedBlackTree[K, V]) getNode(key K) *redBlackNode[K, V] {
+	currentNode := t.root
+	for currentNode != nil {
+		cmp := t.comparator(key, currentNode.key)
+		if cmp == 0 {
+			return currentNode
+		} else if cmp < 0 {
+			currentNode = currentNode.left
+			continue
+		}
+		currentNode = currentNode.right
+	}
+	return nil
+}
+
+func (t *RedBlackTree[K, V]) rotateLeft(node *redBlackNode[K, V]) {
+	pivot := node.right
+	node.right = pivot.left
+	if pivot.left != nil {
+		pivot.left.parent = node
+	}
+	t.replaceChild(node, pivot)
+	pivot.left = node
+	node.parent = pivot
+}
+
+func (t *RedBlackTree[K, V]) rotateRight(node *redBlackNode[K, V]) {
+	pivot := node.left
+	node.left = pivot.right
+	if pivot.right != nil {
+		pivot.right.parent = node
+	}
+	t.replaceChild(node, pivot)
+	pivot.right = node
+	node.parent = pivot
+}
+
+// replaceChild replaces the subtree rooted at oldNode with the subtree rooted at newNode.
+func (t *RedBlackTree[K, V]) replaceChild(oldNode, newNode *redBlackNode[K, V]) {
+	if oldNode.parent == nil {
+		t.root = newNode
+	} else if oldNode == oldNode.parent.left {
+		oldNode.parent.left = newNode
+	} else {
+		oldNode.parent.right = newNode
+	}
+	if newNode != nil {
+		newNode.parent = oldNode.parent
+	}
+}
+
+func (t *RedBlackTree[K, V]) fixAfterInsertion(node *redBlackNode[K, V]) {
+	for node.parent != nil && node.parent.color == red {
+		grandParent := node.parent.parent
+		if node.parent == grandParent.left {
+			uncle := grandParent.right
+			if colorOf(uncle) == red {
+				node.parent.color = black
+				uncle.color = black
+				grandParent.color = red
+				node = grandParent
+				continue
+			}
+			if node == node.parent.right {
+				node = node.parent
+				t.rotateLeft(node)
+			}
+			node.parent.color = black
+			grandParent.color = red
+			t.rotateRight(grandParent)
+			continue
+		}
+
+		uncle := grandParent.left
+		if colorOf(uncle) == red {
+			node.parent.color = black
+			uncle.color = black
+			grandParent.color = red
+			node = grandParent
+			continue
+		}
+		if node == node.parent.left {
+			node = node.parent
+			t.rotateRight(node)
+		}
+		node.parent.color = black
+		grandParent.color = red
+		t.rotateLeft(grandParent)
+	}
+	t.root.color = black
+}
+
+func (t *RedBlackTree[K, V]) removeNode(node *redBlackNode[K, V]) {
+	t.len--
+	removedColor := node.color
+	var child, parent *redBlackNode[K, V]
+	switch {
+	case node.left == nil:
+		child, parent = node.right, node.parent
+		t.replaceChild(node, node.right)
+	case node.right == nil:
+		child, parent = node.left, node.parent
+		t.replaceChild(node, node.left)
+	default:
+		successor := minNode(node.right)
+		removedColor = successor.color
+		child = successor.right
+		if successor.parent == node {
+			parent = successor
+		} else {
+			parent = successor.parent
+			t.replaceChild(successor, successor.right)
+			successor.right = node.right
+			successor.right.parent = successor
+		}
+		t.replaceChild(node, successor)
+		successor.left = node.left
+		successor.left.parent = successor
+		successor.color = node.color
+	}
+
+	if removedColor == black {
+		t.fixAfterRemoval(child, parent)
+	}
+}
+
+// fixAfterRemoval restores red-black invariants after a removal. As leaves are nil, node's parent is passed
+// explicitly.
+func (t *RedBlackTree[K, V]) fixAfterRemoval(node, parent *redBlackNode[K, V]) {
+	for node != t.root && colorOf(node) == black {
+		if node == parent.left {
+			sibling := parent.right
+			if colorOf(sibling) == red {
+				sibling.color = black
+				parent.color = red
+				t.rotateLeft(parent)
+				sibling = parent.right
+			}
+			if colorOf(sibling.left) == black && colorOf(sibling.right) == black {
+				sibling.color = red
+				node, parent = parent, parent.parent
+				continue
+			}
+			if colorOf(sibling.right) == black {
+				sibling.left.color = black
+				sibling.color = red
+				t.rotateRight(sibling)
+				sibling = parent.right
+			}
+			sibling.color = parent.color
+			parent.color = black
+			sibling.right.color = black
+			t.rotateLeft(parent)
+			node, parent = t.root, nil
+			continue
+		}
+
+		sibling := parent.left
+		if colorOf(sibling) == red {
+			sibling.color = black
+			parent.color = red
+			t.rotateRight(parent)
+			sibling = parent.left
+		}
+		if colorOf(sibling.left) == black && colorOf(sibling.right) == black {
+			sibling.color = red
+			node, parent = parent, parent.parent
+			continue
+		}
+		if colorOf(sibling.left) == black {
+			sibling.right.color = black
+			sibling.color = red
+			t.rotateLeft(sibling)
+			sibling = parent.left
+		}
+		sibling.color = parent.color
+		parent.color = black
+		sibling.left.color = black
+		t.rotateRight(parent)
+		node, parent = t.root, nil
+	}
+	if node != nil {
+		node.color = black
+	}
+}
+
+func minNode[K, V any](node *redBlackNode[K, V]) *redBlackNode[K, V] {
+	if node == nil {
+		return nil
+	}
+	for node.left != nil {
+		node = node.left
+	}
+	return node
+}
+
+func maxNode[K, V any](node *redBlackNode[K, V]) *redBlackNode[K, V] {
+	if node == nil {
+		return nil
+	}
+	for node.right != nil {
+		node = node.right
+	}
+	return node
+}
+
+// successor returns the node with the smallest key greater than node's key.
+func successor[K, V any](node *redBlackNode[K, V]) *redBlackNode[K, V] {
+	if node.right != nil {
+		return minNode(node.right)
+	}
+	parent := node.parent
+	for parent != nil && node == parent.right {
+		node, parent = parent, parent.parent
+	}
+	return parent
+}
+
+// predecessor returns the node with the greatest key less than node's key.
+func predecessor[K, V any](node *redBlackNode[K, V]) *redBlackNode[K, V] {
+	if node.left != nil {
+		return maxNode(node.left)
+	}
+	parent := node.parent
+	for parent != nil && node == parent.left {
+		node, parent = parent, parent.parent
+	}
+	return parent
+}
+
+func nodeEntry[K, V any](node *redBlackNode[K, V]) (K, V, bool) {
+	if node == nil {
+		var (
+			zeroKey K
+			zeroVal V
+		)
+		return zeroKey, zeroVal, false
+	}
+	return node.key, node.value, true
+}
+
+// NewIterator returns an iterator over the keys of this tree in ascending (Next) and descending (Previous) order.
+func (t *RedBlackTree[K, V]) NewIterator() collection.Iterator[K] {
+	return &redBlackIterator[K, V]{
+		tree:         t,
+		nextNode:     minNode(t.root),
+		previousNode: maxNode(t.root),
+	}
+}
+
+// Put associates the specified value with the specified key in this tree.
+// Returns true if the key was not present before.
+func (t *RedBlackTree[K, V]) Put(key K, val V) bool {
+	var parent *redBlackNode[K, V]
+	currentNode := t.root
+	cmp := 0
+	for currentNode != nil {
+		parent = currentNode
+		cmp = t.comparator(key, currentNode.key)
+		if cmp == 0 {
+			currentNode.value = val
+			return false
+		} else if cmp < 0 {
+			currentNode = currentNode.left
+			continue
+		}
+		currentNode = currentNode.right
+	}
+
+	newNode := &redBlackNode[K, V]{
+		parent: parent,
+		key:    key,
+		value:  val,
+		color:  red,
+	}
+	if parent == nil {
+		t.root = newNode
+	} else if cmp < 0 {
+		parent.left = newNode
+	} else {
+		parent.right = newNode
+	}
+	t.len++
+	t.fixAfterInsertion(newNode)
+	return true
+}
+
+// Get returns the value to which the specified key is mapped.
+func (t *RedBlackTree[K, V]) Get(key K) (V, bool) {
+	_, val, ok := nodeEntry(t.getNode(key))
+	return val, ok
+}
+
+// ContainsKey returns true if this tree contains the specified key.
+func (t *RedBlackTree[K, V]) ContainsKey(key K) bool {
+	return t.getNode(key) != nil
+}
+
+// Remove removes the specified key from this tree if it is present, returning its value.
+func (t *RedBlackTree[K, V]) Remove(key K) (V, bool) {
+	node := t.getNode(key)
+	if node == nil {
+		var zeroVal V
+		return zeroVal, false
+	}
+	t.removeNode(node)
+	return node.value, true
+}
+
+// Clear removes all the entries from this tree.
+func (t *RedBlackTree[K, V]) Clear() {
+	t.root = nil
+	t.len = 0
+}
+
+// Len returns the number of entries in this tree.
+func (t *RedBlackTree[K, V]) Len() int {
+	return t.len
+}
+
+// IsEmpty returns true if this tree contains no entries.
+func (t *RedBlackTree[K, V]) IsEmpty() bool {
+	return t.len == 0
+}
+
+// Min returns the entry with the smallest key in this tree.
+func (t *RedBlackTree[K, V]) Min() (K, V, bool) {
+	return nodeEntry(minNode(t.root))
+}
+
+// Max returns the entry with the greatest key in this tree.
+func (t *RedBlackTree[K, V]) Max() (K, V, bool) {
+	return nodeEntry(maxNode(t.root))
+}
+
+// Floor returns the entry with the greatest key less than or equal to the given key.
+func (t *RedBlackTree[K, V]) Floor(key K) (K, V, bool) {
+	var candidate *redBlackNode[K, V]
+	currentNode := t.root
+	for currentNode != nil {
+		cmp := t.comparator(key, currentNode.key)
+		if cmp == 0 {
+			return nodeEntry(currentNode)
+		} else if cmp < 0 {
+			currentNode = currentNode.left
+			continue
+		}
+		candidate = currentNode
+		currentNode = currentNode.right
+	}
+	return nodeEntry(candidate)
+}
+
+// Ceiling returns the entry with the smallest key greater than or equal to the given key.
+func (t *RedBlackTree[K, V]) Ceiling(key K) (K, V, bool) {
+	return nodeEntry(t.ceilingNode(key))
+}
+
+func (t *RedBlackTree[K, V]) ceilingNode(key K) *redBlackNode[K, V] {
+	var candidate *redBlackNode[K, V]
+	currentNode := t.root
+	for currentNode != nil {
+		cmp := t.comparator(key, currentNode.key)
+		if cmp == 0 {
+			return currentNode
+		} else if cmp > 0 {
+			currentNode = currentNode.right
+			continue
+		}
+		candidate = currentNode
+		currentNode = currentNode.left
+	}
+	return candidate
+}
+
+// Higher returns the entry with the smallest key strictly greater than the given key.
+func (t *RedBlackTree[K, V]) Higher(key K) (K, V, bool) {
+	return nodeEntry(t.higherNode(key))
+}
+
+func (t *RedBlackTree[K, V]) higherNode(key K) *redBlackNode[K, V] {
+	var candidate *redBlackNode[K, V]
+	currentNode := t.root
+	for currentNode != nil {
+		if t.comparator(key, currentNode.key) < 0 {
+			candidate = currentNode
+			currentNode = currentNode.left
+			continue
+		}
+		currentNode = currentNode.right
+	}
+	return candidate
+}
+
+// Lower returns the entry with the greatest key strictly less than the given key.
+func (t *RedBlackTree[K, V]) Lower(key K) (K, V, bool) {
+	var candidate *redBlackNode[K, V]
+	currentNode := t.root
+	for currentNode != nil {
+		if t.comparator(key, currentNode.key) > 0 {
+			candidate = currentNode
+			currentNode = currentNode.right
+			continue
+		}
+		currentNode = currentNode.left
+	}
+	return nodeEntry(candidate)
+}
+
+// ForEach traverses through all the entries from this tree in ascending key order.
+// Use predicate's return value to indicate a break of the iteration, TRUE meaning a break.
+func (t *RedBlackTree[K, V]) ForEach(predicateFunc collection.IterablePredicateBiFunc[K, V]) {
+	for node := minNode(t.root); node != nil; node = successor(node) {
+		if breakIter := predicateFunc(node.key, node.value); breakIter {
+			break
+		}
+	}
+}
+
+// ForEachFrom traverses through the entries from this tree whose key is greater than (or equal to, if inclusive)
+// fromKey in ascending key order. Takes O(log n) time to find the first entry.
+// Use predicate's return value to indicate a break of the iteration, TRUE meaning a break.
+func (t *RedBlackTree[K, V]) ForEachFrom(fromKey K, inclusive bool,
+	predicateFunc collection.IterablePredicateBiFunc[K, V]) {
+	node := t.higherNode(fromKey)
+	if inclusive {
+		node = t.ceilingNode(fromKey)
+	}
+	for ; node != nil; node = successor(node) {
+		if breakIter := predicateFunc(node.key, node.value); breakIter {
+			break
+		}
+	}
+}
+
+// ForEachReverse traverses through all the entries from this tree in descending key order.
+// Use predicate's return value to indicate a break of the iteration, TRUE meaning a break.
+func (t *RedBlackTree[K, V]) ForEachReverse(predicateFunc collection.IterablePredicateBiFunc[K, V]) {
+	for node := maxNode(t.root); node != nil; node = predecessor(node) {
+		if breakIter := predicateFunc(node.key, node.value); breakIter {
+			break
+		}
+	}
+}
+
+// redBlackIterator is the implementation of collection.Iterator traversing the keys of a RedBlackTree.
+type redBlackIterator[K, V any] struct {
+	tree         *RedBlackTree[K, V]
+	nextNode     *redBlackNode[K, V]
+	previousNode *redBlackNode[K, V]
+}
+
+var _ collection.Iterator[string] = &redBlackIterator[string, int]{}
+
+func (i *redBlackIterator[K, V]) HasNext() bool {
+	return i.nextNode != nil
+}
+
+func (i *redBlackIterator[K, V]) Next() K {
+	if i.nextNode == nil {
+		var zeroKey K
+		return zeroKey
+	}
+	key := i.nextNode.key
+	i.nextNode = successor(i.nextNode)
+	return key
+}
+
+func (i *redBlackIterator[K, V]) HasPrevious() bool {
+	return i.previousNode != nil
+}
+
+func (i *redBlackIterator[K, V]) Previous() K {
+	if i.previousNode == nil {
+		var zeroKey K
+		return zeroKey
+	}
+	key := i.previousNode.key
+	i.previousNode = predecessor(i.previousNode)
+	return key
+}
+
+func (i *redBlackIterator[K, V]) Reset() {
+	i.nextNode = minNode(i.tree.root)
+	i.previousNode = maxNode(i.tree.root)
+}
@@ -0,0 +1,119 @@
+package tree_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutrinocorp/nolan/collection/tree"
+)
+
+func TestRedBlackTree_PutRemove(t *testing.T) {
+	tr := tree.NewOrderedRedBlackTree[int, string]()
+	ref := map[int]string{}
+	rnd := rand.New(rand.NewSource(42))
+	for i := 0; i < 5000; i++ {
+		key := rnd.Intn(250)
+		if rnd.Intn(3) > 0 {
+			_, exists := ref[key]
+			assert.Equal(t, !exists, tr.Put(key, "val"))
+			ref[key] = "val"
+			continue
+		}
+		_, exists := ref[key]
+		_, ok := tr.Remove(key)
+		assert.Equal(t, exists, ok)
+		delete(ref, key)
+	}
+
+	expKeys := make([]int, 0, len(ref))
+	for key := range ref {
+		expKeys = append(expKeys, key)
+	}
+	sort.Ints(expKeys)
+	require.Equal(t, len(expKeys), tr.Len())
+
+	keys := make([]int, 0, tr.Len())
+	tr.ForEach(func(key int, _ string) bool {
+		keys = append(keys, key)
+		return false
+	})
+	assert.Equal(t, expKeys, keys)
+
+	iter := tr.NewIterator()
+	i := 0
+	for iter.HasNext() {
+		assert.Equal(t, expKeys[i], iter.Next())
+		i++
+	}
+	assert.Equal(t, len(expKeys), i)
+	i = len(expKeys) - 1
+	for iter.HasPrevious() {
+		assert.Equal(t, expKeys[i], iter.Previous())
+		i--
+	}
+	assert.Equal(t, -1, i)
+}
+
+func TestRedBlackTree_Navigation(t *testing.T) {
+	tr := tree.NewOrderedRedBlackTree[int, int]()
+	for _, key := range []int{50, 10, 40, 20, 30} {
+		tr.Put(key, key*2)
+	}
+
+	tests := []struct {
+		name    string
+		navFunc func(key int) (int, int, bool)
+		in      int
+		exp     int
+		expOk   bool
+	}{
+		{name: "floor exact", navFunc: tr.Floor, in: 20, exp: 20, expOk: true},
+		{name: "floor between", navFunc: tr.Floor, in: 25, exp: 20, expOk: true},
+		{name: "floor none", navFunc: tr.Floor, in: 5, expOk: false},
+		{name: "ceiling exact", navFunc: tr.Ceiling, in: 20, exp: 20, expOk: true},
+		{name: "ceiling between", navFunc: tr.Ceiling, in: 25, exp: 30, expOk: true},
+		{name: "ceiling none", navFunc: tr.Ceiling, in: 55, expOk: false},
+		{name: "higher exact", navFunc: tr.Higher, in: 20, exp: 30, expOk: true},
+		{name: "higher none", navFunc: tr.Higher, in: 50, expOk: false},
+		{name: "lower exact", navFunc: tr.Lower, in: 20, exp: 10, expOk: true},
+		{name: "lower none", navFunc: tr.Lower, in: 10, expOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, val, ok := tt.navFunc(tt.in)
+			assert.Equal(t, tt.expOk, ok)
+			assert.Equal(t, tt.exp, key)
+			assert.Equal(t, tt.exp*2, val)
+		})
+	}
+
+	minKey, _, _ := tr.Min()
+	maxKey, _, _ := tr.Max()
+	assert.Equal(t, 10, minKey)
+	assert.Equal(t, 50, maxKey)
+}
+
+func TestRedBlackTree_ForEachFrom(t *testing.T) {
+	tr := tree.NewOrderedRedBlackTree[int, string]()
+	for _, key := range []int{40, 10, 30, 20, 50} {
+		tr.Put(key, "")
+	}
+
+	collect := func(fromKey int, inclusive bool) []int {
+		buf := make([]int, 0)
+		tr.ForEachFrom(fromKey, inclusive, func(key int, _ string) bool {
+			buf = append(buf, key)
+			return key >= 40
+		})
+		return buf
+	}
+	assert.Equal(t, []int{20, 30, 40}, collect(20, true))
+	assert.Equal(t, []int{30, 40}, collect(20, false))
+	assert.Equal(t, []int{30, 40}, collect(25, true))
+	assert.Equal(t, []int{}, collect(60, true))
+}
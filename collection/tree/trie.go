@@ -1,3 +1,235 @@
 package tree
 
-// TODO: IMPLEMENT ME
+import (
+	"sort"
+
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+)
+
+// trieNode is a structure used to hold a value (if a key ends at this node), the number of keys stored within its
+// subtree and its children sorted by their byte label.
+type trieNode[V any] struct {
+	children []*trieNode[V]
+	value    V
+	count    int
+	label    byte
+	hasValue bool
+}
+
+func (n *trieNode[V]) childIndex(label byte) (int, bool) {
+	index := sort.Search(len(n.children), func(i int) bool {
+		return n.children[i].label >= label
+	})
+	return index, index < len(n.children) && n.children[index].label == label
+}
+
+func (n *trieNode[V]) getChild(label byte) *trieNode[V] {
+	if index, ok := n.childIndex(label); ok {
+		return n.children[index]
+	}
+	return nil
+}
+
+func (n *trieNode[V]) getOrAddChild(label byte) *trieNode[V] {
+	index, ok := n.childIndex(label)
+	if ok {
+		return n.children[index]
+	}
+	child := &trieNode[V]{label: label}
+	n.children = append(n.children, nil)
+	copy(n.children[index+1:], n.children[index:])
+	n.children[index] = child
+	return child
+}
+
+func (n *trieNode[V]) removeChild(label byte) {
+	if index, ok := n.childIndex(label); ok {
+		copy(n.children[index:], n.children[index+1:])
+		n.children[len(n.children)-1] = nil
+		n.children = n.children[:len(n.children)-1]
+	}
+}
+
+// Trie is a prefix tree storing values associated with string keys. Keys sharing a prefix share the nodes
+// representing it, which makes prefix queries (e.g., KeysWithPrefix, CountWithPrefix, LongestPrefixOf) proportional
+// to the length of the prefix instead of the number of keys.
+//
+// Keys are split in bytes; traversal routines yield keys in lexicographical (byte-wise) order.
+type Trie[V any] struct {
+	root *trieNode[V]
+}
+
+// NewTrie allocates a new Trie instance.
+func NewTrie[V any]() *Trie[V] {
+	return &Trie[V]{
+		root: &trieNode[V]{},
+	}
+}
+
+func (t *Trie[V]) getNode(key string) *trieNode[V] {
+	if t.root == nil {
+		return nil
+	}
+	currentNode := t.root
+	for i := 0; i < len(key) && currentNode != nil; i++ {
+		currentNode = currentNode.getChild(key[i])
+	}
+	return currentNode
+}
+
+// Put associates the specified value with the specified key in this trie.
+func (t *Trie[V]) Put(key string, val V) {
+	if t.root == nil {
+		t.root = &trieNode[V]{}
+	}
+	if node := t.getNode(key); node != nil && node.hasValue {
+		node.value = val
+		return
+	}
+
+	currentNode := t.root
+	currentNode.count++
+	for i := 0; i < len(key); i++ {
+		currentNode = currentNode.getOrAddChild(key[i])
+		currentNode.count++
+	}
+	currentNode.value = val
+	currentNode.hasValue = true
+}
+
+// Get returns the value to which the specified key is mapped.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	node := t.getNode(key)
+	if node == nil || !node.hasValue {
+		var zeroVal V
+		return zeroVal, false
+	}
+	return node.value, true
+}
+
+// ContainsKey returns true if this trie contains a mapping for the specified key.
+func (t *Trie[V]) ContainsKey(key string) bool {
+	node := t.getNode(key)
+	return node != nil && node.hasValue
+}
+
+// Delete removes the mapping for a key from this trie if it is present, returning its value.
+// Nodes no longer leading to any key are pruned.
+func (t *Trie[V]) Delete(key string) (V, bool) {
+	var zeroVal V
+	node := t.getNode(key)
+	if node == nil || !node.hasValue {
+		return zeroVal, false
+	}
+
+	val := node.value
+	currentNode := t.root
+	currentNode.count--
+	for i := 0; i < len(key); i++ {
+		child := currentNode.getChild(key[i])
+		child.count--
+		if child.count == 0 {
+			currentNode.removeChild(key[i])
+			return val, true
+		}
+		currentNode = child
+	}
+	currentNode.value = zeroVal
+	currentNode.hasValue = false
+	return val, true
+}
+
+// Len returns the number of keys in this trie.
+func (t *Trie[V]) Len() int {
+	if t.root == nil {
+		return 0
+	}
+	return t.root.count
+}
+
+// IsEmpty returns true if this trie contains no keys.
+func (t *Trie[V]) IsEmpty() bool {
+	return t.Len() == 0
+}
+
+// Clear removes all the keys from this trie.
+func (t *Trie[V]) Clear() {
+	t.root = &trieNode[V]{}
+}
+
+// CountWithPrefix returns the number of keys starting with the given prefix.
+func (t *Trie[V]) CountWithPrefix(prefix string) int {
+	node := t.getNode(prefix)
+	if node == nil {
+		return 0
+	}
+	return node.count
+}
+
+// KeysWithPrefix returns an iterator over all the keys starting with the given prefix, sorted in lexicographical
+// order.
+func (t *Trie[V]) KeysWithPrefix(prefix string) collection.Iterator[string] {
+	node := t.getNode(prefix)
+	if node == nil {
+		return list.NewIterator[string](list.NewSliceList[string](nil))
+	}
+
+	keys := make([]string, 0, node.count)
+	walkTrie(node, []byte(prefix), func(key []byte, _ V) bool {
+		keys = append(keys, string(key))
+		return false
+	})
+	return list.NewIterator[string](list.NewSliceList(keys))
+}
+
+// LongestPrefixOf returns the longest key in this trie which is a prefix of s.
+func (t *Trie[V]) LongestPrefixOf(s string) (string, bool) {
+	if t.root == nil {
+		return "", false
+	}
+
+	longest := -1
+	currentNode := t.root
+	if currentNode.hasValue {
+		longest = 0
+	}
+	for i := 0; i < len(s); i++ {
+		currentNode = currentNode.getChild(s[i])
+		if currentNode == nil {
+			break
+		}
+		if currentNode.hasValue {
+			longest = i + 1
+		}
+	}
+	if longest < 0 {
+		return "", false
+	}
+	return s[:longest], true
+}
+
+// ForEach traverses through all mappings from this trie in lexicographical key order.
+// Use predicate's return boolean value to indicate a break of the iteration.
+func (t *Trie[V]) ForEach(predicateFunc collection.IterablePredicateBiFunc[string, V]) {
+	if t.root == nil {
+		return
+	}
+	walkTrie(t.root, nil, func(key []byte, val V) bool {
+		return predicateFunc(string(key), val)
+	})
+}
+
+// walkTrie traverses node's subtree in pre-order (i.e., lexicographical order), reusing keyBuf to build keys.
+// Returns true if the traversal was interrupted.
+func walkTrie[V any](node *trieNode[V], keyBuf []byte, predicateFunc func(key []byte, val V) bool) bool {
+	if node.hasValue && predicateFunc(keyBuf, node.value) {
+		return true
+	}
+	for _, child := range node.children {
+		if walkTrie(child, append(keyBuf, child.label), predicateFunc) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package tree_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection/tree"
+)
+
+func newTestTrie() *tree.Trie[int] {
+	tr := tree.NewTrie[int]()
+	for i, key := range []string{"car", "cart", "carbon", "cat", "dog", "do"} {
+		tr.Put(key, i)
+	}
+	return tr
+}
+
+func TestTrie_KeysWithPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		exp    []string
+	}{
+		{name: "empty prefix", prefix: "", exp: []string{"car", "carbon", "cart", "cat", "do", "dog"}},
+		{name: "shared prefix", prefix: "car", exp: []string{"car", "carbon", "cart"}},
+		{name: "exact key", prefix: "dog", exp: []string{"dog"}},
+		{name: "not found", prefix: "cow", exp: []string{}},
+	}
+
+	tr := newTestTrie()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := make([]string, 0)
+			iter := tr.KeysWithPrefix(tt.prefix)
+			for iter.HasNext() {
+				out = append(out, iter.Next())
+			}
+			assert.Equal(t, tt.exp, out)
+			assert.Equal(t, len(tt.exp), tr.CountWithPrefix(tt.prefix))
+		})
+	}
+}
+
+func TestTrie_LongestPrefixOf(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		exp   string
+		expOk bool
+	}{
+		{name: "exact", in: "cart", exp: "cart", expOk: true},
+		{name: "longer", in: "cartography", exp: "cart", expOk: true},
+		{name: "partial", in: "carb", exp: "car", expOk: true},
+		{name: "none", in: "ca", exp: "", expOk: false},
+		{name: "empty", in: "", exp: "", expOk: false},
+	}
+
+	tr := newTestTrie()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, ok := tr.LongestPrefixOf(tt.in)
+			assert.Equal(t, tt.expOk, ok)
+			assert.Equal(t, tt.exp, out)
+		})
+	}
+}
+
+func TestTrie_Delete(t *testing.T) {
+	tr := newTestTrie()
+	val, ok := tr.Delete("car")
+	assert.True(t, ok)
+	assert.Equal(t, 0, val)
+	assert.False(t, tr.ContainsKey("car"))
+	assert.True(t, tr.ContainsKey("cart"))
+	assert.Equal(t, 2, tr.CountWithPrefix("car"))
+
+	_, ok = tr.Delete("car")
+	assert.False(t, ok)
+	tr.Delete("carbon")
+	tr.Delete("cart")
+	assert.Equal(t, 0, tr.CountWithPrefix("car"))
+	assert.Equal(t, 3, tr.Len())
+
+	tr.Put("", -1)
+	val, ok = tr.Get("")
+	assert.True(t, ok)
+	assert.Equal(t, -1, val)
+	assert.Equal(t, 4, tr.Len())
+}
@@ -0,0 +1,114 @@
+package collection_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/collection/set"
+)
+
+// removalFactories holds a factory for every kind of collection removal routines deal with: in place
+// (collection.RemovableCollection) and rebuilt through Clear and AddSlice (set.LinkedHashSet).
+var removalFactories = map[string]func(src []int) collection.Collection[int]{
+	"slice list": func(src []int) collection.Collection[int] {
+		return list.NewSliceList(append([]int(nil), src...))
+	},
+	"doubly linked list": func(src []int) collection.Collection[int] {
+		return list.NewDoublyLinkedListFromSlice(src)
+	},
+	"linked hash set": func(src []int) collection.Collection[int] {
+		return set.NewLinkedHashSetFromSlice(src)
+	},
+}
+
+func TestRemoveIf(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     []int
+		exp    []int
+		expMod bool
+	}{
+		{
+			name: "empty",
+			in:   nil,
+			exp:  nil,
+		},
+		{
+			name: "no match",
+			in:   []int{1, 3, 5},
+			exp:  []int{1, 3, 5},
+		},
+		{
+			name:   "some",
+			in:     []int{1, 2, 3, 4, 5, 6},
+			exp:    []int{1, 3, 5},
+			expMod: true,
+		},
+		{
+			name:   "all",
+			in:     []int{2, 4, 6},
+			exp:    nil,
+			expMod: true,
+		},
+	}
+	for factoryName, factoryFunc := range removalFactories {
+		for _, tt := range tests {
+			t.Run(factoryName+"/"+tt.name, func(t *testing.T) {
+				src := factoryFunc(tt.in)
+				isMod := collection.RemoveIf(src, func(v int) bool {
+					return v%2 == 0
+				})
+				assert.Equal(t, tt.expMod, isMod)
+				assert.Equal(t, len(tt.exp), src.Len())
+				// appending right after a removal must keep the order
+				src.Add(9)
+				assert.Equal(t, append(tt.exp, 9), src.ToSlice())
+			})
+		}
+	}
+}
+
+func TestRemove(t *testing.T) {
+	for factoryName, factoryFunc := range removalFactories {
+		t.Run(factoryName, func(t *testing.T) {
+			src := factoryFunc([]int{1, 2, 3, 2})
+			assert.True(t, collection.Remove(src, 2))
+			assert.False(t, collection.Remove(src, 4))
+			assert.True(t, collection.Remove(src, 1))
+			assert.True(t, collection.Contains(src, 3))
+			assert.False(t, collection.Contains(src, 1))
+		})
+	}
+
+	ls := list.NewSliceList([]int{1, 2, 3, 2})
+	collection.Remove[int](ls, 2)
+	assert.Equal(t, []int{1, 3, 2}, ls.ToSlice())
+}
+
+func TestRemoveAllAndRetainAll(t *testing.T) {
+	items := list.NewSliceList([]int{2, 3, 7})
+	for factoryName, factoryFunc := range removalFactories {
+		t.Run(factoryName, func(t *testing.T) {
+			src := factoryFunc([]int{1, 2, 3, 4, 5})
+			assert.True(t, collection.RemoveAll(src, items))
+			assert.Equal(t, []int{1, 4, 5}, src.ToSlice())
+			assert.False(t, collection.RemoveAll(src, items))
+
+			src = factoryFunc([]int{1, 2, 3, 4, 5})
+			assert.True(t, collection.RetainAll(src, items))
+			assert.Equal(t, []int{2, 3}, src.ToSlice())
+			assert.False(t, collection.RetainAll(src, items))
+		})
+	}
+
+	hashSet := set.HashSet[int]{}
+	hashSet.AddSlice(1, 2, 3, 4, 5)
+	assert.True(t, collection.RetainAll[int](hashSet, items))
+	buf := hashSet.ToSlice()
+	sort.Ints(buf)
+	assert.Equal(t, []int{2, 3}, buf)
+}
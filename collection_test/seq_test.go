@@ -0,0 +1,94 @@
+//go:build go1.23
+
+package collection_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/neutrinocorp/nolan/collection"
+	"github.com/neutrinocorp/nolan/collection/list"
+	"github.com/neutrinocorp/nolan/collection/tree"
+)
+
+// iteratorOnly hides every routine of an Iterable but NewIterator.
+type iteratorOnly[T any] struct {
+	src collection.Iterable[T]
+}
+
+func (i iteratorOnly[T]) NewIterator() collection.Iterator[T] {
+	return i.src.NewIterator()
+}
+
+func TestAll(t *testing.T) {
+	tests := []struct {
+		name string
+		in   collection.Iterable[int]
+		exp  []int
+	}{
+		{
+			name: "empty",
+			in:   list.NewSliceList[int](nil),
+			exp:  nil,
+		},
+		{
+			name: "collection",
+			in:   list.NewSliceList([]int{3, 1, 2}),
+			exp:  []int{3, 1, 2},
+		},
+		{
+			name: "sorted collection",
+			in: func() collection.Iterable[int] {
+				tr := tree.NewOrderedAVLTree[int]()
+				tr.AddSlice(3, 1, 2)
+				return tr
+			}(),
+			exp: []int{1, 2, 3},
+		},
+		{
+			name: "iterable",
+			in:   iteratorOnly[int]{src: list.NewDoublyLinkedListFromSlice([]int{3, 1, 2})},
+			exp:  []int{3, 1, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, slices.Collect(collection.All(tt.in)))
+			var reversed []int
+			for v := range collection.Backward(tt.in) {
+				reversed = append(reversed, v)
+			}
+			slices.Reverse(reversed)
+			assert.Equal(t, tt.exp, reversed)
+		})
+	}
+}
+
+func TestAll_Break(t *testing.T) {
+	srcs := []collection.Iterable[int]{
+		list.NewSliceList([]int{1, 2, 3, 4}),
+		iteratorOnly[int]{src: list.NewSliceList([]int{1, 2, 3, 4})},
+	}
+	for _, src := range srcs {
+		var buf []int
+		for v := range collection.All(src) {
+			if v == 3 {
+				break
+			}
+			buf = append(buf, v)
+		}
+		assert.Equal(t, []int{1, 2}, buf)
+
+		buf = nil
+		for v := range collection.Backward(src) {
+			if v == 2 {
+				break
+			}
+			buf = append(buf, v)
+		}
+		assert.Equal(t, []int{4, 3}, buf)
+	}
+}